@@ -1,13 +1,38 @@
 package configs
 
 import (
+	"errors"
+	"fmt"
 	"github.com/fsnotify/fsnotify"
+	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
-	"github.com/stark-sim/cas/tools"
+	"io/fs"
+	"os"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"time"
 )
 
-var Conf = new(Config)
+const (
+	// ConfigPathEnv 未通过 --config 指定时，从该环境变量读取配置文件路径
+	ConfigPathEnv = "CAS_CONFIG"
+	envPrefix     = "CAS"
+	// 相对于工作目录
+	defaultConfigPath = "config.yaml"
+)
+
+// current 当前生效的配置，只整体替换，不修改
+var current atomic.Pointer[Config]
+
+// Get 当前配置的快照，只读；需要跟随变更的使用方通过 OnChange 注册
+func Get() *Config {
+	if conf := current.Load(); conf != nil {
+		return conf
+	}
+	return &Config{}
+}
 
 type Config struct {
 	DBConfig `mapstructure:"db"`
@@ -15,6 +40,48 @@ type Config struct {
 	Code `mapstructure:"code"`
 
 	APIConfig `mapstructure:"api"`
+
+	RelationConfig `mapstructure:"relation"`
+
+	RoleConfig `mapstructure:"role"`
+
+	WatchConfig `mapstructure:"watch"`
+
+	OutboxConfig `mapstructure:"outbox"`
+
+	WebhookConfig `mapstructure:"webhook"`
+
+	TracingConfig `mapstructure:"tracing"`
+
+	LogConfig `mapstructure:"log"`
+
+	AuthConfig `mapstructure:"auth"`
+
+	CORSConfig `mapstructure:"cors"`
+
+	RateLimitConfig `mapstructure:"rate_limit"`
+}
+
+// AuthConfig 登录凭证，修改后立即生效
+type AuthConfig struct {
+	// 签发 JWT 的密钥，必填
+	SecretKey string `mapstructure:"secret_key"`
+	// 轮换前的密钥，只用于校验，保证轮换时已签发的 token 仍然有效
+	PreviousKeys []string `mapstructure:"previous_keys"`
+}
+
+// CORSConfig 跨域，修改后立即生效
+type CORSConfig struct {
+	// 允许的 Origin，包含 * 时允许任意来源，默认为 *
+	AllowOrigins []string `mapstructure:"allow_origins"`
+}
+
+// RateLimitConfig 按客户端 IP 限流，修改后立即生效
+type RateLimitConfig struct {
+	// 每秒允许的请求数，为 0 时不限流
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+	// 允许的突发请求数，默认与每秒请求数相同
+	Burst int
 }
 
 type Code struct {
@@ -24,41 +91,221 @@ type Code struct {
 type APIConfig struct {
 	HttpPort int `mapstructure:"http_port"`
 	GrpcPort int `mapstructure:"grpc_port"`
+	// 关闭时等待进行中的请求完成的时长，默认 15s
+	DrainTimeout time.Duration `mapstructure:"drain_timeout"`
+	// 标记为未就绪后等待多久再停止接收请求，留给负载均衡摘除流量，默认不等待
+	ShutdownDelay time.Duration `mapstructure:"shutdown_delay"`
+}
+
+/*
+RelationConfig 关系元组的命名空间配置，user 与 role 命名空间内置
+每个 relation 的 rewrite 为若干 userset 的并集，由调用方转换为 rebac.Namespace，例如：
+
+	relation:
+	  namespaces:
+	    - name: document
+	      relations:
+	        - name: parent
+	        - name: owner
+	        - name: viewer
+	          union:
+	            - this: true
+	            - computed_userset: owner
+	            - tuple_to_userset: {tupleset: parent, computed_userset: viewer}
+*/
+type RelationConfig struct {
+	Namespaces []NamespaceConfig
+}
+
+type NamespaceConfig struct {
+	Name      string
+	Relations []RelationRewriteConfig
+}
+
+type RelationRewriteConfig struct {
+	Name string
+	// 为空时等价于只有 this
+	Union []UsersetConfig
+}
+
+// UsersetConfig 三者取其一
+type UsersetConfig struct {
+	This            bool
+	ComputedUserset string                `mapstructure:"computed_userset"`
+	TupleToUserset  *TupleToUsersetConfig `mapstructure:"tuple_to_userset"`
+}
+
+type TupleToUsersetConfig struct {
+	Tupleset        string
+	ComputedUserset string `mapstructure:"computed_userset"`
 }
 
+type RoleConfig struct {
+	// 清理过期授权的间隔，如 1m，默认一分钟
+	SweepInterval time.Duration `mapstructure:"sweep_interval"`
+}
+
+// WatchConfig 变更订阅，未配置时使用 watch 包中的默认值
+type WatchConfig struct {
+	// 每次重新读取游标之前多久的事件，应大于最长的写事务耗时与实例间的时钟偏差
+	Lookback time.Duration `mapstructure:"lookback"`
+	// 轮询审计日志的间隔
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+}
+
+// OutboxConfig 事件发布，事件总会生成回调的投递记录
+type OutboxConfig struct {
+	// 发布的间隔，默认一秒
+	RelayInterval time.Duration `mapstructure:"relay_interval"`
+	// 达到该次数仍失败的事件转为死信，不再发布
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// 同时以 JSON Lines 写入的文件路径，为空时不写入
+	File string
+}
+
+// WebhookConfig 回调投递，未配置时使用 webhook 包中的默认值
+type WebhookConfig struct {
+	// 达到该次数仍失败的投递不再重试
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// 单次请求的超时
+	Timeout time.Duration
+	// 检查待投递记录的间隔
+	DeliverInterval time.Duration `mapstructure:"deliver_interval"`
+}
+
+// LogConfig 日志，级别修改后无需重启即生效
+type LogConfig struct {
+	// trace、debug、info、warn、error，默认 info
+	Level string
+	// json 或 console，默认 json
+	Format   string
+	Sampling LogSamplingConfig
+}
+
+// LogSamplingConfig 每秒内同一位置的 Info 及以下级别日志，前 initial 条全部输出，之后每 thereafter 条输出一条
+type LogSamplingConfig struct {
+	// 为 0 时不采样
+	Initial    int
+	Thereafter int
+}
+
+// tracing.exporter 可选的值
+const (
+	TracingExporterNone     = "none"
+	TracingExporterStdout   = "stdout"
+	TracingExporterOTLPFile = "otlp-file"
+	TracingExporterOTLP     = "otlp"
+)
+
+// TracingConfig 链路追踪，exporter 为空或 none 时不导出
+type TracingConfig struct {
+	// none、stdout、otlp-file 或 otlp
+	Exporter string
+	// otlp 的 collector 地址，如 localhost:4317
+	Endpoint string
+	// otlp 不使用 TLS
+	Insecure bool
+	// otlp-file 写入的文件路径
+	File string
+	// 采样比例，0 或 1 表示全部采样
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+	// 默认为 cas
+	ServiceName string `mapstructure:"service_name"`
+}
+
+// DBConfig 除连接池外，修改后需重启才生效
 type DBConfig struct {
-	Driver   string
-	Host     string
+	// postgres、mysql 或 sqlite3
+	Driver string
+	Host   string
+	// 为 0 时使用数据库的默认端口
 	Port     int
 	Username string
 	Password string
+	// sqlite3 时为数据库文件的路径，不需要 host、port 与账号
 	Database string
+	Pool     DBPoolConfig
+	// 建立连接的超时，sqlite3 时为等待写锁的超时，为 0 时不限制
+	ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+	// 单条语句的超时，mysql 只限制 SELECT，sqlite3 不支持，为 0 时不限制
+	StatementTimeout time.Duration `mapstructure:"statement_timeout"`
+	// 只读副本的 DSN，原样使用，只读的 GraphQL 查询与 gRPC 的 Get、List 发往副本
+	Replicas []string
+	// 调用方写入后多久内的读请求仍在主库执行，默认 5s，为 0 时不保证读己之写
+	StickyWindow time.Duration `mapstructure:"sticky_window"`
 }
 
-func InitConfig() (err error) {
-	// 默认配置文件路径
-	configPath := tools.GetRootPath("/config.yaml")
-	logrus.Printf("===> config path: %s", configPath)
-	// 初始化配置文件
-	viper.SetConfigFile(configPath)
-	viper.WatchConfig()
-	// 观察配置文件变动
+// DBPoolConfig 连接池，修改后立即生效，为 0 时使用 database/sql 的默认值
+type DBPoolConfig struct {
+	MaxOpenConns    int           `mapstructure:"max_open_conns"`
+	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"`
+}
+
+/*
+InitConfig 读取配置，优先级从高到低：CAS_ 开头的环境变量、配置文件、默认值
+path 为空时依次使用环境变量 CAS_CONFIG、工作目录下的 config.yaml，后者不存在时只使用环境变量与默认值
+环境变量名为配置的路径转大写、以下划线连接，如 db.host 对应 CAS_DB_HOST，
+列表以逗号分隔，结构体的列表（如 relation.namespaces）使用 JSON
+配置无效时返回错误，不应继续启动
+*/
+func InitConfig(path string) error {
+	explicit := true
+	if path == "" {
+		path = os.Getenv(ConfigPathEnv)
+	}
+	if path == "" {
+		path, explicit = defaultConfigPath, false
+	}
+	viper.SetEnvPrefix(envPrefix)
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.AutomaticEnv()
+	// 配置文件中没有的字段也要能从环境变量中读取
+	if err := bindEnvs(reflect.TypeOf(Config{}), nil); err != nil {
+		return err
+	}
+	setDefaults()
+	viper.SetConfigFile(path)
+	if err := viper.ReadInConfig(); err != nil {
+		if explicit || !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed at reading config file %s: %w", path, err)
+		}
+		logrus.Printf("===> config file %s not found, using environment variables and defaults", path)
+	} else {
+		logrus.Printf("===> config path: %s", path)
+		watchConfig()
+	}
+	next, err := load()
+	if err != nil {
+		return err
+	}
+	current.Store(next)
+	return nil
+}
+
+// watchConfig 配置文件变动后重新加载，见 reload
+func watchConfig() {
 	viper.OnConfigChange(func(in fsnotify.Event) {
 		logrus.Printf("config file has changed")
-		if err = viper.Unmarshal(&Conf); err != nil {
-			logrus.Errorf("failed at unmarshal config file after change, err: %v", err)
-		}
+		reload()
 	})
-	// 将配置文件读入 viper
-	if err = viper.ReadInConfig(); err != nil {
-		logrus.Errorf("failed at ReadInConfig, err: %v", err)
+	viper.WatchConfig()
+}
+
+// load 解析并校验当前的配置
+func load() (*Config, error) {
+	conf := new(Config)
+	err := viper.Unmarshal(conf, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		jsonStringHook,
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+	)))
+	if err != nil {
+		return nil, fmt.Errorf("failed at unmarshal config: %w", err)
 	}
-	// 解析到变量中
-	if err = viper.Unmarshal(&Conf); err != nil {
-		logrus.Errorf("failed at Unmarshal config file, err: %v", err)
+	if err = conf.Validate(); err != nil {
+		return nil, err
 	}
-	// 从环境变量中覆盖配置
-	//viper.AutomaticEnv()
-	// 返回 nil 或错误
-	return err
+	return conf, nil
 }
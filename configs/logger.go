@@ -4,33 +4,154 @@ import (
 	"fmt"
 	nested "github.com/antonfisher/nested-logrus-formatter"
 	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/tools"
+	"go.opentelemetry.io/otel/trace"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
+	"sync"
+	"time"
 )
 
-func InitLogger() (err error) {
-	// 不打进文件，打进控制台用 grafana 来自动管理最佳
-	logrus.SetFormatter(formatter(true))
+const (
+	LogFormatJSON    = "json"
+	LogFormatConsole = "console"
+)
+
+/*
+InitLogger 按 LogConfig 设置日志格式、级别与采样，配置变更后自动重新设置
+带 ctx 的日志（logrus.WithContext）会附带请求 ID、trace ID 与操作人
+*/
+func InitLogger() error {
+	initHookOnce.Do(func() {
+		logrus.AddHook(contextHook{})
+		OnChange(func(old *Config, next *Config) {
+			if reflect.DeepEqual(old.LogConfig, next.LogConfig) {
+				return
+			}
+			if err := applyLogConfig(next.LogConfig); err != nil {
+				logrus.Errorf("failed at applying log config after change, err: %v", err)
+			}
+		})
+	})
+	return applyLogConfig(Get().LogConfig)
+}
+
+func applyLogConfig(conf LogConfig) (err error) {
+	level := logrus.InfoLevel
+	if conf.Level != "" {
+		if level, err = logrus.ParseLevel(conf.Level); err != nil {
+			return err
+		}
+	}
+	var f logrus.Formatter
+	switch conf.Format {
+	case "", LogFormatJSON:
+		// 不打进文件，打进控制台用 grafana 来自动管理最佳
+		f = &logrus.JSONFormatter{
+			TimestampFormat: time.RFC3339Nano,
+			CallerPrettyfier: func(frame *runtime.Frame) (string, string) {
+				return "", fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+			},
+		}
+	case LogFormatConsole:
+		f = formatter(true)
+	default:
+		return fmt.Errorf("unknown log format %q", conf.Format)
+	}
+	if conf.Sampling.Initial > 0 {
+		f = &samplingFormatter{Formatter: f, initial: conf.Sampling.Initial, thereafter: conf.Sampling.Thereafter}
+	}
+	logrus.SetFormatter(f)
 	logrus.SetReportCaller(true)
-	logrus.SetLevel(logrus.DebugLevel)
+	logrus.SetLevel(level)
 	logrus.Debugln("[Init] init logger done")
-	return err
+	return nil
+}
+
+var initHookOnce sync.Once
+
+// contextHook 从 entry 的 ctx 中取出请求上下文写入字段
+type contextHook struct{}
+
+func (contextHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (contextHook) Fire(entry *logrus.Entry) error {
+	ctx := entry.Context
+	if ctx == nil {
+		return nil
+	}
+	if requestID := tools.GetRequestID(ctx); requestID != "" {
+		entry.Data["request_id"] = requestID
+	}
+	if userID := tools.GetUserID(ctx); userID != 0 {
+		entry.Data["user_id"] = userID
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		entry.Data["trace_id"] = sc.TraceID().String()
+		entry.Data["span_id"] = sc.SpanID().String()
+	}
+	return nil
+}
+
+/*
+samplingFormatter 每秒内同一位置（或同一消息）的 Info 及以下级别日志，前 initial 条全部输出，
+之后每 thereafter 条输出一条，thereafter 为 0 时不再输出；Warn 及以上级别总会输出
+logrus 只能在格式化时丢弃日志，返回空内容即不写入
+*/
+type samplingFormatter struct {
+	logrus.Formatter
+	initial    int
+	thereafter int
+
+	mu     sync.Mutex
+	window time.Time
+	counts map[string]int
+}
+
+func (f *samplingFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if entry.Level <= logrus.WarnLevel || f.sample(entry) {
+		return f.Formatter.Format(entry)
+	}
+	return nil, nil
+}
+
+func (f *samplingFormatter) sample(entry *logrus.Entry) bool {
+	key := entry.Message
+	if entry.HasCaller() {
+		key = fmt.Sprintf("%s:%d", entry.Caller.File, entry.Caller.Line)
+	}
+	key = entry.Level.String() + " " + key
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if window := entry.Time.Truncate(time.Second); !window.Equal(f.window) {
+		f.window = window
+		f.counts = make(map[string]int)
+	}
+	f.counts[key]++
+	n := f.counts[key]
+	if n <= f.initial {
+		return true
+	}
+	return f.thereafter > 0 && (n-f.initial)%f.thereafter == 0
 }
 
 // 自定义日志格式化，将日志打进 console
 func formatter(isConsole bool) *nested.Formatter {
 	fmtter := &nested.Formatter{
-		FieldsOrder:           nil,
-		TimestampFormat:       "2006-01-02 15:04:05",
-		HideKeys:              false,
-		NoColors:              false,
-		NoFieldsColors:        false,
-		NoFieldsSpace:         false,
-		ShowFullLevel:         true,
-		NoUppercaseLevel:      false,
-		TrimMessages:          false,
-		CallerFirst:           true,
+		FieldsOrder:      nil,
+		TimestampFormat:  "2006-01-02 15:04:05",
+		HideKeys:         false,
+		NoColors:         false,
+		NoFieldsColors:   false,
+		NoFieldsSpace:    false,
+		ShowFullLevel:    true,
+		NoUppercaseLevel: false,
+		TrimMessages:     false,
+		CallerFirst:      true,
 		CustomCallerFormatter: func(frame *runtime.Frame) string {
 			funcInfo := runtime.FuncForPC(frame.PC)
 			if funcInfo == nil {
@@ -38,11 +159,11 @@ func formatter(isConsole bool) *nested.Formatter {
 			}
 			fullPath, line := funcInfo.FileLine(frame.PC)
 			funcSlice := strings.Split(funcInfo.Name(), ".")
-			funcName := funcSlice[len(funcSlice) - 1]
+			funcName := funcSlice[len(funcSlice)-1]
 			return fmt.Sprintf(" [%v]-[%v]-[%v]", filepath.Base(fullPath), funcName, line)
 		},
 	}
 	// 打进控制台需要颜色
 	fmtter.NoColors = !isConsole
 	return fmtter
-}
\ No newline at end of file
+}
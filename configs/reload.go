@@ -0,0 +1,81 @@
+package configs
+
+import (
+	"github.com/sirupsen/logrus"
+	"reflect"
+	"sync"
+)
+
+// Listener 配置变更后调用，old 与 next 均为只读的快照
+type Listener func(old *Config, next *Config)
+
+var (
+	listenersMu sync.Mutex
+	listeners   []Listener
+	// 重新加载串行执行，保证监听方按顺序收到变更
+	reloadMu sync.Mutex
+)
+
+/*
+OnChange 注册配置变更的监听，配置文件变动且新配置有效时按注册顺序调用
+监听方应只处理自己关心的字段是否变化，不应阻塞
+*/
+func OnChange(listener Listener) {
+	listenersMu.Lock()
+	defer listenersMu.Unlock()
+	listeners = append(listeners, listener)
+}
+
+/*
+reload 重新加载配置并整体替换快照
+无法在运行中生效的字段（监听端口、数据库连接等）保持原值并输出警告，需重启才生效
+*/
+func reload() {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	next, err := load()
+	if err != nil {
+		logrus.Errorf("ignored invalid config after change, err: %v", err)
+		return
+	}
+	old := Get()
+	keepStatic(old, next)
+	current.Store(next)
+	listenersMu.Lock()
+	notify := append([]Listener(nil), listeners...)
+	listenersMu.Unlock()
+	for _, listener := range notify {
+		listener(old, next)
+	}
+}
+
+/*
+keepStatic 可以在运行中生效的只有日志、跨域、限流、JWT 密钥与连接池，
+其余字段有变化时恢复为原值
+*/
+func keepStatic(old *Config, next *Config) {
+	// 连接池可以生效，连接参数不行
+	nextPool := next.DBConfig.Pool
+	next.DBConfig.Pool = old.DBConfig.Pool
+	reject("db", &old.DBConfig, &next.DBConfig)
+	next.DBConfig.Pool = nextPool
+
+	reject("api", &old.APIConfig, &next.APIConfig)
+	reject("code", &old.Code, &next.Code)
+	reject("relation", &old.RelationConfig, &next.RelationConfig)
+	reject("role", &old.RoleConfig, &next.RoleConfig)
+	reject("watch", &old.WatchConfig, &next.WatchConfig)
+	reject("outbox", &old.OutboxConfig, &next.OutboxConfig)
+	reject("webhook", &old.WebhookConfig, &next.WebhookConfig)
+	reject("tracing", &old.TracingConfig, &next.TracingConfig)
+}
+
+// reject old 与 next 指向同类型的配置段，有变化时恢复为原值
+func reject(section string, old interface{}, next interface{}) {
+	oldValue, nextValue := reflect.ValueOf(old).Elem(), reflect.ValueOf(next).Elem()
+	if reflect.DeepEqual(oldValue.Interface(), nextValue.Interface()) {
+		return
+	}
+	logrus.Warnf("config %s cannot be changed without a restart, keeping the current values", section)
+	nextValue.Set(oldValue)
+}
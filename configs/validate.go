@@ -0,0 +1,160 @@
+package configs
+
+import (
+	"encoding/json"
+	"entgo.io/ent/dialect"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/stark-sim/cas/tools"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// SupportedDBDrivers db.driver 可选的值
+var SupportedDBDrivers = []string{dialect.Postgres, dialect.MySQL, dialect.SQLite}
+
+// DefaultDBPorts db.port 为 0 时使用的端口
+var DefaultDBPorts = map[string]int{
+	dialect.Postgres: 5432,
+	dialect.MySQL:    3306,
+}
+
+// 未配置时的默认值，其余字段的默认值由使用方处理
+func setDefaults() {
+	viper.SetDefault("api.http_port", 8080)
+	viper.SetDefault("api.grpc_port", 8081)
+	viper.SetDefault("db.driver", "postgres")
+	viper.SetDefault("db.host", "localhost")
+	viper.SetDefault("db.sticky_window", 5*time.Second)
+	viper.SetDefault("log.level", "info")
+	viper.SetDefault("log.format", LogFormatJSON)
+	viper.SetDefault("tracing.exporter", TracingExporterNone)
+	viper.SetDefault("tracing.service_name", "cas")
+	viper.SetDefault("cors.allow_origins", []string{"*"})
+}
+
+/*
+bindEnvs 为每个字段绑定环境变量，viper 只会为已知的 key 读取环境变量
+key 与 Unmarshal 时一致：mapstructure tag，没有 tag 时为小写的字段名
+*/
+func bindEnvs(t reflect.Type, path []string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("mapstructure"), ",")[0]
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		key := append(append([]string(nil), path...), name)
+		if field.Type.Kind() == reflect.Struct {
+			if err := bindEnvs(field.Type, key); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := viper.BindEnv(strings.Join(key, ".")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonStringHook 环境变量只能是字符串，结构体的列表以 JSON 传入
+func jsonStringHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if from.Kind() != reflect.String || to.Kind() != reflect.Slice || to.Elem().Kind() != reflect.Struct {
+		return data, nil
+	}
+	var res []map[string]interface{}
+	if err := json.Unmarshal([]byte(data.(string)), &res); err != nil {
+		return nil, fmt.Errorf("expected a JSON array: %w", err)
+	}
+	return res, nil
+}
+
+// Validate 检查所有配置，一次返回全部问题
+func (c *Config) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+	validPort := func(port int) bool {
+		return port > 0 && port < 65536
+	}
+	check(validPort(c.APIConfig.HttpPort), "api.http_port %d is not a valid port", c.APIConfig.HttpPort)
+	check(validPort(c.APIConfig.GrpcPort), "api.grpc_port %d is not a valid port", c.APIConfig.GrpcPort)
+	check(c.APIConfig.HttpPort != c.APIConfig.GrpcPort, "api.http_port and api.grpc_port must differ")
+
+	check(tools.IsOneOf(c.DBConfig.Driver, SupportedDBDrivers...), "db.driver %q is not one of %v", c.DBConfig.Driver, SupportedDBDrivers)
+	if c.DBConfig.Driver == dialect.SQLite {
+		// 只需要数据库文件的路径
+		check(len(c.DBConfig.Replicas) == 0, "db.replicas is not supported by sqlite3")
+	} else {
+		check(c.DBConfig.Host != "", "db.host is required")
+		check(c.DBConfig.Port == 0 || validPort(c.DBConfig.Port), "db.port %d is not a valid port", c.DBConfig.Port)
+		check(c.DBConfig.Username != "", "db.username is required")
+		check(c.DBConfig.Password != "", "db.password is required")
+	}
+	check(c.DBConfig.Database != "", "db.database is required")
+
+	for i, dsn := range c.DBConfig.Replicas {
+		check(dsn != "", "db.replicas[%d] must not be empty", i)
+	}
+
+	check(c.AuthConfig.SecretKey != "", "auth.secret_key is required")
+
+	_, err := logrus.ParseLevel(c.LogConfig.Level)
+	check(err == nil, "log.level %q is not a valid level", c.LogConfig.Level)
+	check(tools.IsOneOf(c.LogConfig.Format, LogFormatJSON, LogFormatConsole), "log.format %q is not one of json, console", c.LogConfig.Format)
+	check(c.LogConfig.Sampling.Initial >= 0 && c.LogConfig.Sampling.Thereafter >= 0, "log.sampling must not be negative")
+
+	switch c.TracingConfig.Exporter {
+	case "", TracingExporterNone, TracingExporterStdout:
+	case TracingExporterOTLPFile:
+		check(c.TracingConfig.File != "", "tracing.file is required by the otlp-file exporter")
+	case TracingExporterOTLP:
+		check(c.TracingConfig.Endpoint != "", "tracing.endpoint is required by the otlp exporter")
+	default:
+		check(false, "tracing.exporter %q is not one of none, stdout, otlp-file, otlp", c.TracingConfig.Exporter)
+	}
+	check(c.TracingConfig.SampleRatio >= 0 && c.TracingConfig.SampleRatio <= 1, "tracing.sample_ratio must be between 0 and 1")
+
+	check(c.RateLimitConfig.RequestsPerSecond >= 0 && c.RateLimitConfig.Burst >= 0, "rate_limit must not be negative")
+	pool := c.DBConfig.Pool
+	check(pool.MaxOpenConns >= 0 && pool.MaxIdleConns >= 0, "db.pool connection limits must not be negative")
+	check(c.OutboxConfig.MaxAttempts >= 0, "outbox.max_attempts must not be negative")
+	check(c.WebhookConfig.MaxAttempts >= 0, "webhook.max_attempts must not be negative")
+	durations := []struct {
+		key   string
+		value time.Duration
+	}{
+		{"api.drain_timeout", c.APIConfig.DrainTimeout},
+		{"api.shutdown_delay", c.APIConfig.ShutdownDelay},
+		{"role.sweep_interval", c.RoleConfig.SweepInterval},
+		{"watch.lookback", c.WatchConfig.Lookback},
+		{"watch.poll_interval", c.WatchConfig.PollInterval},
+		{"outbox.relay_interval", c.OutboxConfig.RelayInterval},
+		{"webhook.timeout", c.WebhookConfig.Timeout},
+		{"webhook.deliver_interval", c.WebhookConfig.DeliverInterval},
+		{"db.connect_timeout", c.DBConfig.ConnectTimeout},
+		{"db.statement_timeout", c.DBConfig.StatementTimeout},
+		{"db.sticky_window", c.DBConfig.StickyWindow},
+		{"db.pool.conn_max_lifetime", pool.ConnMaxLifetime},
+		{"db.pool.conn_max_idle_time", pool.ConnMaxIdleTime},
+	}
+	for _, d := range durations {
+		check(d.value >= 0, "%s must not be negative", d.key)
+	}
+	for _, ns := range c.RelationConfig.Namespaces {
+		check(ns.Name != "", "relation.namespaces: name is required")
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid config: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -1,6 +0,0 @@
-#!/bin/sh
-
-# 后台启动 GRPC
-./grpc_server &
-# 前台启动 HTTP
-./http_server
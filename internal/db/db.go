@@ -1,28 +1,53 @@
 package db
 
 import (
+	"context"
+	"database/sql"
+	entsql "entgo.io/ent/dialect/sql"
 	"errors"
 	"fmt"
 	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/mysql"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/database/sqlite3"
+	"github.com/golang-migrate/migrate/v4/source"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/sirupsen/logrus"
 	"github.com/stark-sim/cas/configs"
+	"github.com/stark-sim/cas/pkg/audit"
 	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/metrics"
+	"github.com/stark-sim/cas/pkg/outbox"
+	"github.com/stark-sim/cas/pkg/tracing"
+	"os"
+	// 加载 schema 中的默认值与 hook
+	_ "github.com/stark-sim/cas/pkg/ent/runtime"
 )
 
-var url = ""
+var (
+	url = ""
+	// NewDBClient 打开的连接池，供健康检查使用
+	sqlDB *sql.DB
+	// 迁移文件中最新的版本
+	expectedVersion uint
+)
+
+const (
+	// 与 database/sql 的默认值一致，SetMaxIdleConns(0) 表示不保留空闲连接
+	defaultMaxIdleConns = 2
+)
 
 func InitDB() (err error) {
-	dbConf := configs.Conf.DBConfig
-	url = fmt.Sprintf("%s://%s:%s@%s:%v/%s?sslmode=disable&TimeZone=Asia/Shanghai", dbConf.Driver, dbConf.Username, dbConf.Password, dbConf.Host, dbConf.Port, dbConf.Database)
-	err = migrateWithMigrationFiles()
+	dbConf := configs.Get().DBConfig
+	url = migrateURL(dbConf)
+	err = migrateWithMigrationFiles(dbConf.Driver)
 	return err
 }
 
-func migrateWithMigrationFiles() (err error) {
-	m, err := migrate.New("file://internal/db/migrations", url)
+func migrateWithMigrationFiles(driver string) (err error) {
+	m, err := migrate.New(migrationsSource(driver), url)
 	if err != nil {
 		logrus.Errorf("failed at new migrate, err: %v", err)
 		return err
@@ -32,17 +57,133 @@ func migrateWithMigrationFiles() (err error) {
 		logrus.Errorf("faied at migrating: %v", err)
 		return err
 	}
+	expectedVersion, _, err = m.Version()
+	return err
+}
+
+/*
+LoadMigrations 只读取迁移文件中最新的版本，不执行迁移
+不应修改数据库的命令在 CheckMigrations 前调用
+*/
+func LoadMigrations() error {
+	src, err := source.Open(migrationsSource(configs.Get().DBConfig.Driver))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	version, err := src.First()
+	if err != nil {
+		return err
+	}
+	for {
+		next, err := src.Next(version)
+		// 没有更新的版本
+		if errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		version = next
+	}
+	expectedVersion = version
 	return nil
 }
 
-func NewDBClient() *ent.Client {
-	dbConf := configs.Conf.DBConfig
-	dataSourceName := fmt.Sprintf("host=%s port=%v user=%s password=%s dbname=%s sslmode=disable TimeZone=Asia/Shanghai", dbConf.Host, dbConf.Port, dbConf.Username, dbConf.Password, dbConf.Database)
-	logrus.Debugf("dsn: %s\n", dataSourceName)
-	client, err := ent.Open(dbConf.Driver, dataSourceName)
+// Ping 检查数据库连接
+func Ping(ctx context.Context) error {
+	if sqlDB == nil {
+		return errors.New("database is not opened")
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+/*
+CheckMigrations 检查数据库已迁移到本程序携带的最新版本，且没有执行失败的迁移
+滚动发布时新版本可能已执行了更新的迁移，版本更高不算错误
+*/
+func CheckMigrations(ctx context.Context) error {
+	if sqlDB == nil {
+		return errors.New("database is not opened")
+	}
+	var version uint
+	var dirty bool
+	if err := sqlDB.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty); err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("migration %d is dirty", version)
+	}
+	if version < expectedVersion {
+		return fmt.Errorf("database is at migration %d, expected %d", version, expectedVersion)
+	}
+	return nil
+}
+
+/*
+NewDBClient 打开主库与只读副本的连接池，整个进程共用一个 client
+查询在标记了 WithReadReplica 的 ctx 中发往副本，见 replica.go
+*/
+func NewDBClient() (*ent.Client, error) {
+	dbConf := configs.Get().DBConfig
+	primary, err := open(dbConf.Driver, dataSourceName(dbConf), dbConf.Database)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB = primary.DB()
+	pools := []*entsql.Driver{primary}
+	replicas := make([]txDriver, 0, len(dbConf.Replicas))
+	for i, dsn := range dbConf.Replicas {
+		replica, err := open(dbConf.Driver, dsn, fmt.Sprintf("%s_replica_%d", dbConf.Database, i))
+		if err != nil {
+			for _, v := range pools {
+				_ = v.Close()
+			}
+			return nil, fmt.Errorf("replica %d: %w", i, err)
+		}
+		pools = append(pools, replica)
+		// 每条 SQL 语句记录一个 span
+		replicas = append(replicas, tracing.NewDriver(replica))
+	}
+	configs.OnChange(func(old *configs.Config, next *configs.Config) {
+		if old.DBConfig.Pool != next.DBConfig.Pool {
+			for _, v := range pools {
+				applyPool(v.DB(), next.DBConfig.Pool)
+			}
+			logrus.Printf("applied db pool config: %+v", next.DBConfig.Pool)
+		}
+	})
+	drv := newRoutingDriver(tracing.NewDriver(primary), replicas, dbConf.StickyWindow)
+	client := ent.NewClient(ent.Driver(drv))
+	// 记录 User、Role、UserRole 的每次变更
+	audit.Register(client)
+	// 身份事件写入 outbox，由 relay 发布到消息总线
+	outbox.Register(client)
+	return client, nil
+}
+
+// open 打开连接池并应用连接池配置，name 用于区分指标
+func open(driver string, dsn string, name string) (*entsql.Driver, error) {
+	drv, err := entsql.Open(driver, dsn)
 	if err != nil {
-		logrus.Errorf("failed at creating ent client with db %s, err: %v", dataSourceName, err)
-		return nil
+		logrus.Errorf("failed at opening db %s, err: %v", name, err)
+		return nil, err
+	}
+	applyPool(drv.DB(), configs.Get().DBConfig.Pool)
+	if err = metrics.RegisterDB(drv.DB(), name); err != nil {
+		logrus.Warnf("failed at registering db metrics: %v", err)
+	}
+	return drv, nil
+}
+
+// applyPool 连接池的设置可以在运行中修改，为 0 的项使用 database/sql 的默认值
+func applyPool(db *sql.DB, conf configs.DBPoolConfig) {
+	db.SetMaxOpenConns(conf.MaxOpenConns)
+	maxIdleConns := conf.MaxIdleConns
+	if maxIdleConns <= 0 {
+		maxIdleConns = defaultMaxIdleConns
 	}
-	return client
+	db.SetMaxIdleConns(maxIdleConns)
+	db.SetConnMaxLifetime(conf.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(conf.ConnMaxIdleTime)
 }
\ No newline at end of file
@@ -0,0 +1,112 @@
+package db
+
+import (
+	"fmt"
+	"math"
+	"net"
+	nurl "net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stark-sim/cas/configs"
+)
+
+// 会话使用的时区
+const timeZone = "Asia/Shanghai"
+
+// migrationsSource 每种数据库有各自的迁移文件，由 make_migrations.go 生成
+func migrationsSource(driver string) string {
+	return "file://" + path.Join("internal/db/migrations", driver)
+}
+
+// dataSourceName 主库的连接参数，由 ent 使用
+func dataSourceName(dbConf configs.DBConfig) string {
+	switch dbConf.Driver {
+	case dialect.MySQL:
+		return mysqlConfig(dbConf).FormatDSN()
+	case dialect.SQLite:
+		return sqliteDSN(dbConf)
+	default:
+		return postgresDSN(dbConf)
+	}
+}
+
+// migrateURL golang-migrate 使用的地址
+func migrateURL(dbConf configs.DBConfig) string {
+	switch dbConf.Driver {
+	case dialect.MySQL:
+		// golang-migrate 会对用户名与密码再做一次 URL 解码
+		conf := mysqlConfig(dbConf)
+		conf.User = nurl.QueryEscape(conf.User)
+		conf.Passwd = nurl.QueryEscape(conf.Passwd)
+		return "mysql://" + conf.FormatDSN()
+	case dialect.SQLite:
+		return "sqlite3://" + sqliteDSN(dbConf)
+	default:
+		return fmt.Sprintf("postgres://%s:%s@%s:%v/%s?sslmode=disable&TimeZone=%s", dbConf.Username, dbConf.Password, dbConf.Host, port(dbConf), dbConf.Database, timeZone)
+	}
+}
+
+// port 为 0 时使用数据库的默认端口
+func port(dbConf configs.DBConfig) int {
+	if dbConf.Port == 0 {
+		return configs.DefaultDBPorts[dbConf.Driver]
+	}
+	return dbConf.Port
+}
+
+// postgresDSN 连接超时以秒为单位，语句超时以毫秒为单位
+func postgresDSN(dbConf configs.DBConfig) string {
+	dsn := fmt.Sprintf("host=%s port=%v user=%s password=%s dbname=%s sslmode=disable TimeZone=%s", dbConf.Host, port(dbConf), dbConf.Username, dbConf.Password, dbConf.Database, timeZone)
+	if dbConf.ConnectTimeout > 0 {
+		dsn += fmt.Sprintf(" connect_timeout=%d", int(math.Ceil(dbConf.ConnectTimeout.Seconds())))
+	}
+	if dbConf.StatementTimeout > 0 {
+		dsn += fmt.Sprintf(" statement_timeout=%d", dbConf.StatementTimeout.Milliseconds())
+	}
+	return dsn
+}
+
+// mysqlConfig MySQL 的语句超时只对 SELECT 生效
+func mysqlConfig(dbConf configs.DBConfig) *mysql.Config {
+	conf := mysql.NewConfig()
+	conf.User = dbConf.Username
+	conf.Passwd = dbConf.Password
+	conf.Net = "tcp"
+	conf.Addr = net.JoinHostPort(dbConf.Host, strconv.Itoa(port(dbConf)))
+	conf.DBName = dbConf.Database
+	conf.ParseTime = true
+	conf.Timeout = dbConf.ConnectTimeout
+	conf.Params = map[string]string{
+		// 不依赖服务端加载时区表，Asia/Shanghai 没有夏令时
+		"time_zone": "'+08:00'",
+		// 零值时间以 0000-00-00 写入，默认的严格模式不允许
+		"sql_mode": "'STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION'",
+	}
+	if dbConf.StatementTimeout > 0 {
+		conf.Params["max_execution_time"] = strconv.FormatInt(dbConf.StatementTimeout.Milliseconds(), 10)
+	}
+	if loc, err := time.LoadLocation(timeZone); err == nil {
+		conf.Loc = loc
+	}
+	return conf
+}
+
+/*
+sqliteDSN Database 为数据库文件的路径
+SQLite 没有建立连接的过程，连接超时用作等待写锁的超时；事务开始时即获取写锁，避免读后写升级锁失败
+*/
+func sqliteDSN(dbConf configs.DBConfig) string {
+	query := nurl.Values{}
+	query.Set("_fk", "1")
+	query.Set("_journal_mode", "WAL")
+	query.Set("_txlock", "immediate")
+	query.Set("_loc", timeZone)
+	if dbConf.ConnectTimeout > 0 {
+		query.Set("_busy_timeout", strconv.FormatInt(dbConf.ConnectTimeout.Milliseconds(), 10))
+	}
+	return dbConf.Database + "?" + query.Encode()
+}
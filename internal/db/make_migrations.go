@@ -6,34 +6,40 @@ package main
 import (
 	"ariga.io/atlas/sql/sqltool"
 	"context"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql/schema"
 	"fmt"
+	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/sirupsen/logrus"
 	"github.com/stark-sim/cas/configs"
 	"github.com/stark-sim/cas/pkg/ent/migrate"
 	"os"
+	"path/filepath"
 )
 
 func main() {
-	// 创建文件夹
-	err := os.Mkdir("./migrations", 0777)
-	if err != nil && !os.IsExist(err) {
-		logrus.Errorf("failed at mkdir migrations")
-	}
 	ctx := context.Background()
-	// 指定文件夹
-	dir, err := sqltool.NewGolangMigrateDir("./migrations")
+	// 需要初始化数据库配置
+	err := configs.InitConfig("")
 	if err != nil {
-		logrus.Errorf("failed at creating atlas migration directory: %v", err)
+		logrus.Errorf("failed at loading config: %v", err)
 		return
 	}
-	// 需要初始化数据库配置
-	err = configs.InitConfig()
+	dbConf := configs.Get().DBConfig
+	// 每种数据库有各自的迁移文件夹
+	path := filepath.Join("./migrations", dbConf.Driver)
+	err = os.MkdirAll(path, 0777)
 	if err != nil {
+		logrus.Errorf("failed at mkdir %s", path)
+	}
+	// 指定文件夹
+	dir, err := sqltool.NewGolangMigrateDir(path)
+	if err != nil {
+		logrus.Errorf("failed at creating atlas migration directory: %v", err)
 		return
 	}
-	dbConf := configs.Conf.DBConfig
 	// 迁移条件
 	opts := []schema.MigrateOption{
 		schema.WithDir(dir),
@@ -50,12 +56,26 @@ func main() {
 		// 可删索引
 		schema.WithDropIndex(true),
 	}
-	// 需知道数据库目标
-	dbUrl := fmt.Sprintf("%s://%s:%s@%s:%v/%s?sslmode=disable&TimeZone=Asia/Shanghai", dbConf.Driver, dbConf.Username, dbConf.Password, dbConf.Host, dbConf.Port, dbConf.Database)
 	// 开始创建迁移文件
-	err = migrate.NamedDiff(ctx, dbUrl, "update", opts...)
+	err = migrate.NamedDiff(ctx, dbURL(dbConf), "update", opts...)
 	if err != nil {
 		logrus.Errorf("failed at generating migrations, err: %v", err)
 	}
 	return
 }
+
+// dbURL 需知道数据库目标，atlas 的地址格式与 golang-migrate 不完全一致
+func dbURL(dbConf configs.DBConfig) string {
+	port := dbConf.Port
+	if port == 0 {
+		port = configs.DefaultDBPorts[dbConf.Driver]
+	}
+	switch dbConf.Driver {
+	case dialect.MySQL:
+		return fmt.Sprintf("mysql://%s:%s@%s:%v/%s?parseTime=true", dbConf.Username, dbConf.Password, dbConf.Host, port, dbConf.Database)
+	case dialect.SQLite:
+		return fmt.Sprintf("sqlite3://%s?_fk=1", dbConf.Database)
+	default:
+		return fmt.Sprintf("postgres://%s:%s@%s:%v/%s?sslmode=disable&TimeZone=Asia/Shanghai", dbConf.Username, dbConf.Password, dbConf.Host, port, dbConf.Database)
+	}
+}
@@ -0,0 +1,18 @@
+-- reverse: create "webhook_subscriptions" table
+DROP TABLE `webhook_subscriptions`;
+-- reverse: create "webhook_deliveries" table
+DROP TABLE `webhook_deliveries`;
+-- reverse: create "user_roles" table
+DROP TABLE `user_roles`;
+-- reverse: create "users" table
+DROP TABLE `users`;
+-- reverse: create "roles" table
+DROP TABLE `roles`;
+-- reverse: create "relation_tuples" table
+DROP TABLE `relation_tuples`;
+-- reverse: create "outbox_events" table
+DROP TABLE `outbox_events`;
+-- reverse: create "audit_events" table
+DROP TABLE `audit_events`;
+-- reverse: create "access_policies" table
+DROP TABLE `access_policies`;
@@ -0,0 +1,18 @@
+-- create "access_policies" table
+CREATE TABLE `access_policies` (`id` bigint NOT NULL, `created_by` bigint NOT NULL DEFAULT 0, `updated_by` bigint NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted_at` timestamp NOT NULL, `name` varchar(255) NOT NULL DEFAULT '', `description` varchar(255) NOT NULL DEFAULT '', `effect` enum('ALLOW','DENY') NOT NULL DEFAULT 'ALLOW', `action` varchar(255) NOT NULL DEFAULT '*', `resource` varchar(255) NOT NULL DEFAULT '*', `condition` longtext NOT NULL, `enabled` bool NOT NULL DEFAULT true, PRIMARY KEY (`id`), INDEX `accesspolicy_resource_action` (`resource`, `action`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "audit_events" table
+CREATE TABLE `audit_events` (`id` bigint NOT NULL, `created_at` timestamp NOT NULL, `actor_id` bigint NOT NULL DEFAULT 0, `operation` enum('CREATE','UPDATE','DELETE','PURGE') NOT NULL, `entity_type` varchar(255) NOT NULL, `entity_id` bigint NOT NULL, `changes` json NULL, `client_ip` varchar(255) NOT NULL DEFAULT '', `request_id` varchar(255) NOT NULL DEFAULT '', PRIMARY KEY (`id`), INDEX `auditevent_entity_type_entity_id` (`entity_type`, `entity_id`), INDEX `auditevent_actor_id` (`actor_id`), INDEX `auditevent_created_at` (`created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "outbox_events" table
+CREATE TABLE `outbox_events` (`id` bigint NOT NULL, `created_at` timestamp NOT NULL, `event_type` varchar(255) NOT NULL, `aggregate_type` varchar(255) NOT NULL, `aggregate_id` bigint NOT NULL, `payload` json NULL, `published_at` timestamp NOT NULL, `attempts` bigint NOT NULL DEFAULT 0, `last_error` varchar(255) NOT NULL DEFAULT '', PRIMARY KEY (`id`), INDEX `outboxevent_published_at` (`published_at`), INDEX `outboxevent_aggregate_type_aggregate_id` (`aggregate_type`, `aggregate_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "relation_tuples" table
+CREATE TABLE `relation_tuples` (`id` bigint NOT NULL, `created_by` bigint NOT NULL DEFAULT 0, `updated_by` bigint NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted_at` timestamp NOT NULL, `namespace` varchar(64) NOT NULL, `object_id` varchar(191) NOT NULL, `relation` varchar(64) NOT NULL, `subject_namespace` varchar(64) NOT NULL, `subject_id` varchar(191) NOT NULL, `subject_relation` varchar(64) NOT NULL DEFAULT '', PRIMARY KEY (`id`), UNIQUE INDEX `relationtuple_tuple_deleted_at` (`namespace`, `object_id`, `relation`, `subject_namespace`, `subject_id`, `subject_relation`, `deleted_at`), INDEX `relationtuple_subject_namespace_subject_id` (`subject_namespace`, `subject_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "roles" table
+CREATE TABLE `roles` (`id` bigint NOT NULL, `created_by` bigint NOT NULL DEFAULT 0, `updated_by` bigint NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted_at` timestamp NOT NULL, `name` varchar(255) NOT NULL DEFAULT '', PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "users" table
+CREATE TABLE `users` (`id` bigint NOT NULL, `created_by` bigint NOT NULL DEFAULT 0, `updated_by` bigint NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted_at` timestamp NOT NULL, `name` varchar(255) NOT NULL DEFAULT '', `phone` varchar(255) NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `user_phone_deleted_at` (`phone`, `deleted_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "user_roles" table
+CREATE TABLE `user_roles` (`id` bigint NOT NULL, `created_by` bigint NOT NULL DEFAULT 0, `updated_by` bigint NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted_at` timestamp NOT NULL, `valid_from` timestamp NOT NULL, `valid_until` timestamp NOT NULL, `user_id` bigint NOT NULL, `role_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `userrole_user_id_role_id` (`user_id`, `role_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "webhook_deliveries" table
+CREATE TABLE `webhook_deliveries` (`id` bigint NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `event_id` bigint NOT NULL, `event_type` varchar(255) NOT NULL, `body` longtext NOT NULL, `status` enum('PENDING','SUCCEEDED','FAILED','DEAD') NOT NULL DEFAULT 'PENDING', `attempts` bigint NOT NULL DEFAULT 0, `next_attempt_at` timestamp NOT NULL, `response_status` bigint NOT NULL DEFAULT 0, `last_error` varchar(255) NOT NULL DEFAULT '', `delivered_at` timestamp NOT NULL, `subscription_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `webhookdelivery_subscription_id_event_id` (`subscription_id`, `event_id`), INDEX `webhookdelivery_status_next_attempt_at` (`status`, `next_attempt_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "webhook_subscriptions" table
+CREATE TABLE `webhook_subscriptions` (`id` bigint NOT NULL, `created_by` bigint NOT NULL DEFAULT 0, `updated_by` bigint NOT NULL DEFAULT 0, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted_at` timestamp NOT NULL, `name` varchar(255) NOT NULL DEFAULT '', `url` varchar(255) NOT NULL, `event_types` json NULL, `secret` varchar(255) NOT NULL, `enabled` bool NOT NULL DEFAULT true, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
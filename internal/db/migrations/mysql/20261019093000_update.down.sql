@@ -0,0 +1,2 @@
+-- reverse: modify "outbox_events" table
+ALTER TABLE `outbox_events` DROP COLUMN `dead_at`, DROP COLUMN `claimed_until`;
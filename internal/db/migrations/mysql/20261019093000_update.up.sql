@@ -0,0 +1,2 @@
+-- modify "outbox_events" table
+ALTER TABLE `outbox_events` ADD COLUMN `claimed_until` timestamp NOT NULL, ADD COLUMN `dead_at` timestamp NOT NULL;
@@ -0,0 +1,4 @@
+-- reverse: create index "accesspolicy_resource_action" to table: "access_policies"
+DROP INDEX "accesspolicy_resource_action";
+-- reverse: create "access_policies" table
+DROP TABLE "access_policies";
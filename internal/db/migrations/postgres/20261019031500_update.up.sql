@@ -0,0 +1,4 @@
+-- create "access_policies" table
+CREATE TABLE "access_policies" ("id" bigint NOT NULL, "created_by" bigint NOT NULL DEFAULT 0, "updated_by" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "deleted_at" timestamptz NOT NULL, "name" character varying NOT NULL DEFAULT '', "description" character varying NOT NULL DEFAULT '', "effect" character varying NOT NULL DEFAULT 'ALLOW', "action" character varying NOT NULL DEFAULT '*', "resource" character varying NOT NULL DEFAULT '*', "condition" text NOT NULL DEFAULT 'true', "enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+-- create index "accesspolicy_resource_action" to table: "access_policies"
+CREATE INDEX "accesspolicy_resource_action" ON "access_policies" ("resource", "action");
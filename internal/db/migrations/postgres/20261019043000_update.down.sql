@@ -0,0 +1,6 @@
+-- reverse: create index "relationtuple_subject_namespace_subject_id" to table: "relation_tuples"
+DROP INDEX "relationtuple_subject_namespace_subject_id";
+-- reverse: create index "relationtuple_tuple_deleted_at" to table: "relation_tuples"
+DROP INDEX "relationtuple_tuple_deleted_at";
+-- reverse: create "relation_tuples" table
+DROP TABLE "relation_tuples";
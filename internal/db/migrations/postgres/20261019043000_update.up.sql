@@ -0,0 +1,6 @@
+-- create "relation_tuples" table
+CREATE TABLE "relation_tuples" ("id" bigint NOT NULL, "created_by" bigint NOT NULL DEFAULT 0, "updated_by" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "deleted_at" timestamptz NOT NULL, "namespace" character varying NOT NULL, "object_id" character varying NOT NULL, "relation" character varying NOT NULL, "subject_namespace" character varying NOT NULL, "subject_id" character varying NOT NULL, "subject_relation" character varying NOT NULL DEFAULT '', PRIMARY KEY ("id"));
+-- create index "relationtuple_tuple_deleted_at" to table: "relation_tuples"
+CREATE UNIQUE INDEX "relationtuple_tuple_deleted_at" ON "relation_tuples" ("namespace", "object_id", "relation", "subject_namespace", "subject_id", "subject_relation", "deleted_at");
+-- create index "relationtuple_subject_namespace_subject_id" to table: "relation_tuples"
+CREATE INDEX "relationtuple_subject_namespace_subject_id" ON "relation_tuples" ("subject_namespace", "subject_id");
@@ -0,0 +1,2 @@
+-- reverse: modify "user_roles" table
+ALTER TABLE "user_roles" DROP COLUMN "valid_until", DROP COLUMN "valid_from";
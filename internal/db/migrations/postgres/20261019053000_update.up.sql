@@ -0,0 +1,4 @@
+-- modify "user_roles" table
+ALTER TABLE "user_roles" ADD COLUMN "valid_from" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00+00', ADD COLUMN "valid_until" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00+00';
+-- existing grants stay permanent, new rows get their window from ent
+ALTER TABLE "user_roles" ALTER COLUMN "valid_from" DROP DEFAULT, ALTER COLUMN "valid_until" DROP DEFAULT;
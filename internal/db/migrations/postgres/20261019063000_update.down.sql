@@ -0,0 +1,8 @@
+-- reverse: create index "auditevent_created_at" to table: "audit_events"
+DROP INDEX "auditevent_created_at";
+-- reverse: create index "auditevent_actor_id" to table: "audit_events"
+DROP INDEX "auditevent_actor_id";
+-- reverse: create index "auditevent_entity_type_entity_id" to table: "audit_events"
+DROP INDEX "auditevent_entity_type_entity_id";
+-- reverse: create "audit_events" table
+DROP TABLE "audit_events";
@@ -0,0 +1,8 @@
+-- create "audit_events" table
+CREATE TABLE "audit_events" ("id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "actor_id" bigint NOT NULL DEFAULT 0, "operation" character varying NOT NULL, "entity_type" character varying NOT NULL, "entity_id" bigint NOT NULL, "changes" jsonb NULL, "client_ip" character varying NOT NULL DEFAULT '', "request_id" character varying NOT NULL DEFAULT '', PRIMARY KEY ("id"));
+-- create index "auditevent_entity_type_entity_id" to table: "audit_events"
+CREATE INDEX "auditevent_entity_type_entity_id" ON "audit_events" ("entity_type", "entity_id");
+-- create index "auditevent_actor_id" to table: "audit_events"
+CREATE INDEX "auditevent_actor_id" ON "audit_events" ("actor_id");
+-- create index "auditevent_created_at" to table: "audit_events"
+CREATE INDEX "auditevent_created_at" ON "audit_events" ("created_at");
@@ -0,0 +1,6 @@
+-- reverse: create index "outboxevent_aggregate_type_aggregate_id" to table: "outbox_events"
+DROP INDEX "outboxevent_aggregate_type_aggregate_id";
+-- reverse: create index "outboxevent_published_at" to table: "outbox_events"
+DROP INDEX "outboxevent_published_at";
+-- reverse: create "outbox_events" table
+DROP TABLE "outbox_events";
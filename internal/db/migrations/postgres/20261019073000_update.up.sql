@@ -0,0 +1,6 @@
+-- create "outbox_events" table
+CREATE TABLE "outbox_events" ("id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "event_type" character varying NOT NULL, "aggregate_type" character varying NOT NULL, "aggregate_id" bigint NOT NULL, "payload" jsonb NULL, "published_at" timestamptz NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "last_error" character varying NOT NULL DEFAULT '', PRIMARY KEY ("id"));
+-- create index "outboxevent_published_at" to table: "outbox_events"
+CREATE INDEX "outboxevent_published_at" ON "outbox_events" ("published_at");
+-- create index "outboxevent_aggregate_type_aggregate_id" to table: "outbox_events"
+CREATE INDEX "outboxevent_aggregate_type_aggregate_id" ON "outbox_events" ("aggregate_type", "aggregate_id");
@@ -0,0 +1,8 @@
+-- reverse: create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_status_next_attempt_at";
+-- reverse: create index "webhookdelivery_subscription_id_event_id" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_subscription_id_event_id";
+-- reverse: create "webhook_deliveries" table
+DROP TABLE "webhook_deliveries";
+-- reverse: create "webhook_subscriptions" table
+DROP TABLE "webhook_subscriptions";
@@ -0,0 +1,8 @@
+-- create "webhook_subscriptions" table
+CREATE TABLE "webhook_subscriptions" ("id" bigint NOT NULL, "created_by" bigint NOT NULL DEFAULT 0, "updated_by" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "deleted_at" timestamptz NOT NULL, "name" character varying NOT NULL DEFAULT '', "url" character varying NOT NULL, "event_types" jsonb NULL, "secret" character varying NOT NULL, "enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+-- create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "event_id" bigint NOT NULL, "event_type" character varying NOT NULL, "body" text NOT NULL, "status" character varying NOT NULL DEFAULT 'PENDING', "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NOT NULL, "response_status" bigint NOT NULL DEFAULT 0, "last_error" character varying NOT NULL DEFAULT '', "delivered_at" timestamptz NOT NULL, "subscription_id" bigint NOT NULL, PRIMARY KEY ("id"));
+-- create index "webhookdelivery_subscription_id_event_id" to table: "webhook_deliveries"
+CREATE UNIQUE INDEX "webhookdelivery_subscription_id_event_id" ON "webhook_deliveries" ("subscription_id", "event_id");
+-- create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
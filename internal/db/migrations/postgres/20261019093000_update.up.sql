@@ -0,0 +1,4 @@
+-- modify "outbox_events" table
+ALTER TABLE "outbox_events" ADD COLUMN "claimed_until" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00+00', ADD COLUMN "dead_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00+00';
+-- existing events are unclaimed and alive, new rows get their values from ent
+ALTER TABLE "outbox_events" ALTER COLUMN "claimed_until" DROP DEFAULT, ALTER COLUMN "dead_at" DROP DEFAULT;
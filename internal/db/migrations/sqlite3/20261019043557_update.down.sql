@@ -0,0 +1,42 @@
+-- reverse: create "webhook_subscriptions" table
+DROP TABLE `webhook_subscriptions`;
+-- reverse: create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+DROP INDEX `webhookdelivery_status_next_attempt_at`;
+-- reverse: create index "webhookdelivery_subscription_id_event_id" to table: "webhook_deliveries"
+DROP INDEX `webhookdelivery_subscription_id_event_id`;
+-- reverse: create "webhook_deliveries" table
+DROP TABLE `webhook_deliveries`;
+-- reverse: create index "userrole_user_id_role_id" to table: "user_roles"
+DROP INDEX `userrole_user_id_role_id`;
+-- reverse: create "user_roles" table
+DROP TABLE `user_roles`;
+-- reverse: create index "user_phone_deleted_at" to table: "users"
+DROP INDEX `user_phone_deleted_at`;
+-- reverse: create "users" table
+DROP TABLE `users`;
+-- reverse: create "roles" table
+DROP TABLE `roles`;
+-- reverse: create index "relationtuple_subject_namespace_subject_id" to table: "relation_tuples"
+DROP INDEX `relationtuple_subject_namespace_subject_id`;
+-- reverse: create index "relationtuple_tuple_deleted_at" to table: "relation_tuples"
+DROP INDEX `relationtuple_tuple_deleted_at`;
+-- reverse: create "relation_tuples" table
+DROP TABLE `relation_tuples`;
+-- reverse: create index "outboxevent_aggregate_type_aggregate_id" to table: "outbox_events"
+DROP INDEX `outboxevent_aggregate_type_aggregate_id`;
+-- reverse: create index "outboxevent_published_at" to table: "outbox_events"
+DROP INDEX `outboxevent_published_at`;
+-- reverse: create "outbox_events" table
+DROP TABLE `outbox_events`;
+-- reverse: create index "auditevent_created_at" to table: "audit_events"
+DROP INDEX `auditevent_created_at`;
+-- reverse: create index "auditevent_actor_id" to table: "audit_events"
+DROP INDEX `auditevent_actor_id`;
+-- reverse: create index "auditevent_entity_type_entity_id" to table: "audit_events"
+DROP INDEX `auditevent_entity_type_entity_id`;
+-- reverse: create "audit_events" table
+DROP TABLE `audit_events`;
+-- reverse: create index "accesspolicy_resource_action" to table: "access_policies"
+DROP INDEX `accesspolicy_resource_action`;
+-- reverse: create "access_policies" table
+DROP TABLE `access_policies`;
@@ -0,0 +1,42 @@
+-- create "access_policies" table
+CREATE TABLE `access_policies` (`id` integer NOT NULL, `created_by` integer NOT NULL DEFAULT 0, `updated_by` integer NOT NULL DEFAULT 0, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NOT NULL, `name` text NOT NULL DEFAULT '', `description` text NOT NULL DEFAULT '', `effect` text NOT NULL DEFAULT 'ALLOW', `action` text NOT NULL DEFAULT '*', `resource` text NOT NULL DEFAULT '*', `condition` text NOT NULL DEFAULT 'true', `enabled` bool NOT NULL DEFAULT true, PRIMARY KEY (`id`));
+-- create index "accesspolicy_resource_action" to table: "access_policies"
+CREATE INDEX `accesspolicy_resource_action` ON `access_policies` (`resource`, `action`);
+-- create "audit_events" table
+CREATE TABLE `audit_events` (`id` integer NOT NULL, `created_at` datetime NOT NULL, `actor_id` integer NOT NULL DEFAULT 0, `operation` text NOT NULL, `entity_type` text NOT NULL, `entity_id` integer NOT NULL, `changes` json NULL, `client_ip` text NOT NULL DEFAULT '', `request_id` text NOT NULL DEFAULT '', PRIMARY KEY (`id`));
+-- create index "auditevent_entity_type_entity_id" to table: "audit_events"
+CREATE INDEX `auditevent_entity_type_entity_id` ON `audit_events` (`entity_type`, `entity_id`);
+-- create index "auditevent_actor_id" to table: "audit_events"
+CREATE INDEX `auditevent_actor_id` ON `audit_events` (`actor_id`);
+-- create index "auditevent_created_at" to table: "audit_events"
+CREATE INDEX `auditevent_created_at` ON `audit_events` (`created_at`);
+-- create "outbox_events" table
+CREATE TABLE `outbox_events` (`id` integer NOT NULL, `created_at` datetime NOT NULL, `event_type` text NOT NULL, `aggregate_type` text NOT NULL, `aggregate_id` integer NOT NULL, `payload` json NULL, `published_at` datetime NOT NULL, `attempts` integer NOT NULL DEFAULT 0, `last_error` text NOT NULL DEFAULT '', PRIMARY KEY (`id`));
+-- create index "outboxevent_published_at" to table: "outbox_events"
+CREATE INDEX `outboxevent_published_at` ON `outbox_events` (`published_at`);
+-- create index "outboxevent_aggregate_type_aggregate_id" to table: "outbox_events"
+CREATE INDEX `outboxevent_aggregate_type_aggregate_id` ON `outbox_events` (`aggregate_type`, `aggregate_id`);
+-- create "relation_tuples" table
+CREATE TABLE `relation_tuples` (`id` integer NOT NULL, `created_by` integer NOT NULL DEFAULT 0, `updated_by` integer NOT NULL DEFAULT 0, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NOT NULL, `namespace` text NOT NULL, `object_id` text NOT NULL, `relation` text NOT NULL, `subject_namespace` text NOT NULL, `subject_id` text NOT NULL, `subject_relation` text NOT NULL DEFAULT '', PRIMARY KEY (`id`));
+-- create index "relationtuple_tuple_deleted_at" to table: "relation_tuples"
+CREATE UNIQUE INDEX `relationtuple_tuple_deleted_at` ON `relation_tuples` (`namespace`, `object_id`, `relation`, `subject_namespace`, `subject_id`, `subject_relation`, `deleted_at`);
+-- create index "relationtuple_subject_namespace_subject_id" to table: "relation_tuples"
+CREATE INDEX `relationtuple_subject_namespace_subject_id` ON `relation_tuples` (`subject_namespace`, `subject_id`);
+-- create "roles" table
+CREATE TABLE `roles` (`id` integer NOT NULL, `created_by` integer NOT NULL DEFAULT 0, `updated_by` integer NOT NULL DEFAULT 0, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NOT NULL, `name` text NOT NULL DEFAULT '', PRIMARY KEY (`id`));
+-- create "users" table
+CREATE TABLE `users` (`id` integer NOT NULL, `created_by` integer NOT NULL DEFAULT 0, `updated_by` integer NOT NULL DEFAULT 0, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NOT NULL, `name` text NOT NULL DEFAULT '', `phone` text NOT NULL, PRIMARY KEY (`id`));
+-- create index "user_phone_deleted_at" to table: "users"
+CREATE UNIQUE INDEX `user_phone_deleted_at` ON `users` (`phone`, `deleted_at`);
+-- create "user_roles" table
+CREATE TABLE `user_roles` (`id` integer NOT NULL, `created_by` integer NOT NULL DEFAULT 0, `updated_by` integer NOT NULL DEFAULT 0, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NOT NULL, `valid_from` datetime NOT NULL, `valid_until` datetime NOT NULL, `user_id` integer NOT NULL, `role_id` integer NOT NULL, PRIMARY KEY (`id`));
+-- create index "userrole_user_id_role_id" to table: "user_roles"
+CREATE UNIQUE INDEX `userrole_user_id_role_id` ON `user_roles` (`user_id`, `role_id`);
+-- create "webhook_deliveries" table
+CREATE TABLE `webhook_deliveries` (`id` integer NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `event_id` integer NOT NULL, `event_type` text NOT NULL, `body` text NOT NULL, `status` text NOT NULL DEFAULT 'PENDING', `attempts` integer NOT NULL DEFAULT 0, `next_attempt_at` datetime NOT NULL, `response_status` integer NOT NULL DEFAULT 0, `last_error` text NOT NULL DEFAULT '', `delivered_at` datetime NOT NULL, `subscription_id` integer NOT NULL, PRIMARY KEY (`id`));
+-- create index "webhookdelivery_subscription_id_event_id" to table: "webhook_deliveries"
+CREATE UNIQUE INDEX `webhookdelivery_subscription_id_event_id` ON `webhook_deliveries` (`subscription_id`, `event_id`);
+-- create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX `webhookdelivery_status_next_attempt_at` ON `webhook_deliveries` (`status`, `next_attempt_at`);
+-- create "webhook_subscriptions" table
+CREATE TABLE `webhook_subscriptions` (`id` integer NOT NULL, `created_by` integer NOT NULL DEFAULT 0, `updated_by` integer NOT NULL DEFAULT 0, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NOT NULL, `name` text NOT NULL DEFAULT '', `url` text NOT NULL, `event_types` json NULL, `secret` text NOT NULL, `enabled` bool NOT NULL DEFAULT true, PRIMARY KEY (`id`));
@@ -0,0 +1,4 @@
+-- reverse: add column "dead_at" to table: "outbox_events"
+ALTER TABLE `outbox_events` DROP COLUMN `dead_at`;
+-- reverse: add column "claimed_until" to table: "outbox_events"
+ALTER TABLE `outbox_events` DROP COLUMN `claimed_until`;
@@ -0,0 +1,4 @@
+-- add column "claimed_until" to table: "outbox_events"
+ALTER TABLE `outbox_events` ADD COLUMN `claimed_until` datetime NOT NULL DEFAULT '0001-01-01 00:00:00+00:00';
+-- add column "dead_at" to table: "outbox_events"
+ALTER TABLE `outbox_events` ADD COLUMN `dead_at` datetime NOT NULL DEFAULT '0001-01-01 00:00:00+00:00';
@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"entgo.io/ent/dialect"
+	"github.com/stark-sim/cas/tools"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+只读请求标记后，查询发往只读副本；未标记的查询、所有写入与事务都在主库执行
+调用方刚写入后的 stickyWindow 内，其只读请求仍在主库执行，以读到自己的写入
+*/
+
+type readReplicaKey struct{}
+
+type primaryKey struct{}
+
+// WithReadReplica 标记为只读请求，查询可以发往只读副本
+func WithReadReplica(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readReplicaKey{}, true)
+}
+
+// WithPrimary 强制在主库执行，优先于 WithReadReplica
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+func useReplica(ctx context.Context) bool {
+	if primary, _ := ctx.Value(primaryKey{}).(bool); primary {
+		return false
+	}
+	replica, _ := ctx.Value(readReplicaKey{}).(bool)
+	return replica
+}
+
+// txDriver ent 的 BeginTx 要求驱动实现该方法
+type txDriver interface {
+	dialect.Driver
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error)
+}
+
+// routingDriver 在主库与只读副本之间选择，副本之间轮询
+type routingDriver struct {
+	txDriver
+	replicas []txDriver
+	next     atomic.Uint64
+	writes   *recentWrites
+}
+
+func newRoutingDriver(primary txDriver, replicas []txDriver, stickyWindow time.Duration) *routingDriver {
+	return &routingDriver{txDriver: primary, replicas: replicas, writes: newRecentWrites(stickyWindow)}
+}
+
+func (d *routingDriver) Query(ctx context.Context, query string, args, v any) error {
+	if len(d.replicas) > 0 && useReplica(ctx) && !d.writes.recent(callerKey(ctx)) {
+		replica := d.replicas[d.next.Add(1)%uint64(len(d.replicas))]
+		return replica.Query(ctx, query, args, v)
+	}
+	return d.txDriver.Query(ctx, query, args, v)
+}
+
+func (d *routingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	d.writes.mark(callerKey(ctx))
+	return d.txDriver.Exec(ctx, query, args, v)
+}
+
+func (d *routingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+func (d *routingDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	d.writes.mark(callerKey(ctx))
+	return d.txDriver.BeginTx(ctx, opts)
+}
+
+func (d *routingDriver) Close() error {
+	err := d.txDriver.Close()
+	for _, replica := range d.replicas {
+		if e := replica.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// callerKey 已登录时为用户，否则为客户端 IP，后台任务为空
+func callerKey(ctx context.Context) string {
+	if userID := tools.GetUserID(ctx); userID != 0 {
+		return "user:" + strconv.FormatInt(userID, 10)
+	}
+	if clientIP := tools.GetClientIP(ctx); clientIP != "" {
+		return "ip:" + clientIP
+	}
+	return ""
+}
+
+/*
+recentWrites 记录调用方最近一次写入的时间，只在本进程内有效
+多实例部署时，跨实例的读己之写需要调用方通过请求头要求读主库
+*/
+type recentWrites struct {
+	window    time.Duration
+	mu        sync.Mutex
+	writes    map[string]time.Time
+	lastSweep time.Time
+}
+
+func newRecentWrites(window time.Duration) *recentWrites {
+	return &recentWrites{window: window, writes: make(map[string]time.Time), lastSweep: time.Now()}
+}
+
+func (w *recentWrites) mark(key string) {
+	if key == "" || w.window <= 0 {
+		return
+	}
+	now := time.Now()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes[key] = now
+	if now.Sub(w.lastSweep) > w.window {
+		for k, t := range w.writes {
+			if now.Sub(t) > w.window {
+				delete(w.writes, k)
+			}
+		}
+		w.lastSweep = now
+	}
+}
+
+func (w *recentWrites) recent(key string) bool {
+	if key == "" || w.window <= 0 {
+		return false
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	t, ok := w.writes[key]
+	return ok && time.Since(t) <= w.window
+}
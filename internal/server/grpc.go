@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/configs"
+	"github.com/stark-sim/cas/pkg/abac"
+	pb "github.com/stark-sim/cas/pkg/grpc/pb"
+	"github.com/stark-sim/cas/pkg/grpc/servers"
+	"github.com/stark-sim/cas/pkg/metrics"
+	"github.com/stark-sim/cas/pkg/rebac"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net"
+)
+
+type grpcServer struct {
+	srv     *grpc.Server
+	health  *health.Server
+	address string
+}
+
+// newGRPCServer ctx 结束时停止同步健康状态
+func (a *App) newGRPCServer(ctx context.Context) (*grpcServer, error) {
+	client := a.Client
+	policyEngine := abac.NewEngine(client)
+	// gRPC 服务初始化
+	// 要将业务注册进该服务中
+	srv := grpc.NewServer(
+		// trace context 从 metadata 的 traceparent 中恢复
+		grpc.ChainUnaryInterceptor(otelgrpc.UnaryServerInterceptor(), metrics.UnaryServerInterceptor, requestMetaInterceptor, loggingInterceptor, rateLimitInterceptor(a.Limiter), authInterceptor(policyEngine), readReplicaInterceptor, txInterceptor(client)),
+		grpc.ChainStreamInterceptor(otelgrpc.StreamServerInterceptor(), metrics.StreamServerInterceptor, requestMetaStreamInterceptor, authStreamInterceptor(policyEngine)),
+	)
+	// 注册 service 到 server 中
+	pb.RegisterUserServiceServer(srv, &servers.UserServer{Client: client})
+	pb.RegisterRoleServiceServer(srv, &servers.RoleServer{Client: client})
+	pb.RegisterWatchServiceServer(srv, &servers.WatchServer{Client: client, Broker: a.Broker})
+	pb.RegisterPolicyServiceServer(srv, &servers.PolicyServer{Engine: policyEngine})
+	relationEngine, err := rebac.NewEngine(client, relationNamespaces(configs.Get().RelationConfig)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed at loading relation namespaces: %w", err)
+	}
+	pb.RegisterRelationServiceServer(srv, &servers.RelationServer{Engine: relationEngine})
+	// 标准的 grpc.health.v1，状态与 /readyz 的检查保持一致，检查通过前为 NOT_SERVING
+	healthServer := health.NewServer()
+	services := []string{""}
+	for name := range srv.GetServiceInfo() {
+		services = append(services, name)
+	}
+	for _, service := range services {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	healthpb.RegisterHealthServer(srv, healthServer)
+	go a.syncHealth(ctx, healthServer, services)
+	return &grpcServer{srv: srv, health: healthServer, address: fmt.Sprintf(":%d", configs.Get().APIConfig.GrpcPort)}, nil
+}
+
+func (s *grpcServer) name() string {
+	return "grpc"
+}
+
+func (s *grpcServer) addr() string {
+	return s.address
+}
+
+func (s *grpcServer) serve(lis net.Listener) error {
+	// GracefulStop 之后 Serve 返回 nil
+	return s.srv.Serve(lis)
+}
+
+// markNotReady 所有服务置为 NOT_SERVING，且不再更新
+func (s *grpcServer) markNotReady() {
+	s.health.Shutdown()
+}
+
+func (s *grpcServer) stop(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		s.srv.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		logrus.Warnf("grpc server drain timed out, closing remaining connections")
+		s.srv.Stop()
+	}
+}
+
+// relationNamespaces 把配置中的命名空间转换为 rebac 使用的定义
+func relationNamespaces(conf configs.RelationConfig) []rebac.Namespace {
+	res := make([]rebac.Namespace, 0, len(conf.Namespaces))
+	for _, ns := range conf.Namespaces {
+		namespace := rebac.Namespace{Name: ns.Name, Relations: make([]rebac.Relation, 0, len(ns.Relations))}
+		for _, r := range ns.Relations {
+			relation := rebac.Relation{Name: r.Name}
+			for _, u := range r.Union {
+				userset := rebac.Userset{This: u.This, ComputedUserset: u.ComputedUserset}
+				if u.TupleToUserset != nil {
+					userset.TupleToUserset = &rebac.TupleToUserset{Tupleset: u.TupleToUserset.Tupleset, ComputedUserset: u.TupleToUserset.ComputedUserset}
+				}
+				relation.Union = append(relation.Union, userset)
+			}
+			namespace.Relations = append(namespace.Relations, relation)
+		}
+		res = append(res, namespace)
+	}
+	return res
+}
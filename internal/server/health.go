@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/stark-sim/cas/internal/db"
+	"github.com/stark-sim/cas/tools"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	"time"
+)
+
+const (
+	// 单次就绪检查的超时
+	readyTimeout = 2 * time.Second
+	// gRPC 健康状态的刷新间隔
+	healthInterval = 5 * time.Second
+)
+
+// readyCheck 就绪检查项，返回 nil 表示通过
+type readyCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+var readyChecks = []readyCheck{
+	{name: "database", check: db.Ping},
+	{name: "migrations", check: db.CheckMigrations},
+	{name: "signing_key", check: func(context.Context) error {
+		return tools.CheckSigningKey()
+	}},
+}
+
+// checkReady 逐项检查，返回每项的结果，通过为 ok，否则为错误信息
+func (a *App) checkReady(ctx context.Context) (map[string]string, bool) {
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+	results := make(map[string]string, len(readyChecks)+1)
+	ready := a.Ready()
+	if !ready {
+		results["server"] = "shutting down"
+	}
+	for _, v := range readyChecks {
+		if err := v.check(ctx); err != nil {
+			results[v.name] = err.Error()
+			ready = false
+		} else {
+			results[v.name] = "ok"
+		}
+	}
+	return results, ready
+}
+
+// healthzHandler 存活检查，进程能响应即可
+func healthzHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	}
+}
+
+// readyzHandler 就绪检查，未通过时返回 503，负载均衡据此摘除流量
+func (a *App) readyzHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		results, ready := a.checkReady(c.Request.Context())
+		status, code := "ok", http.StatusOK
+		if !ready {
+			status, code = "unavailable", http.StatusServiceUnavailable
+		}
+		c.JSON(code, gin.H{"status": status, "checks": results})
+	}
+}
+
+/*
+syncHealth 定期以与 /readyz 相同的检查更新 gRPC 健康状态，ctx 结束时退出
+services 为注册的服务名，空字符串表示整个服务
+*/
+func (a *App) syncHealth(ctx context.Context, healthServer *health.Server, services []string) {
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		if _, ready := a.checkReady(ctx); !ready {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		for _, service := range services {
+			healthServer.SetServingStatus(service, status)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
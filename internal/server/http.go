@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"entgo.io/contrib/entgql"
+	"errors"
+	"fmt"
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/configs"
+	"github.com/stark-sim/cas/internal/db"
+	httpMiddlewares "github.com/stark-sim/cas/internal/server/middlewares"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/graphql"
+	"github.com/stark-sim/cas/pkg/graphql/middlewares"
+	"github.com/stark-sim/cas/pkg/metrics"
+	"github.com/stark-sim/cas/pkg/tracing"
+	"github.com/stark-sim/cas/tools"
+	"github.com/vektah/gqlparser/v2/ast"
+	"net"
+	"net/http"
+	"time"
+)
+
+type httpServer struct {
+	srv *http.Server
+	// 强制关闭时取消进行中的请求
+	cancel context.CancelFunc
+}
+
+func (a *App) newHTTPServer() *httpServer {
+	// 结合 gin 启动 http 服务
+	r := gin.New()
+	r.Use(gin.Recovery())
+	r.Use(httpMiddlewares.Tracing())
+	r.Use(middlewares.WriterMiddleware())
+	r.Use(httpMiddlewares.CORS())
+	r.Use(httpMiddlewares.RequestMeta())
+	r.Use(httpMiddlewares.Logger())
+	r.Use(httpMiddlewares.Metrics())
+	shutdown := make(chan struct{})
+	graphqlServer := a.graphqlHandler(shutdown)
+	// 只对业务请求限流，健康检查与指标不受影响
+	rateLimit := httpMiddlewares.RateLimit(a.Limiter)
+	r.POST("/graphql", rateLimit, graphqlServer)
+	// 订阅通过 websocket 连接
+	r.GET("/graphql", rateLimit, graphqlServer)
+	r.GET("/", playgroundHandler())
+	r.GET("/healthz", healthzHandler())
+	r.GET("/readyz", a.readyzHandler())
+	r.GET("/metrics", gin.WrapH(metrics.Handler()))
+	baseCtx, cancel := context.WithCancel(context.Background())
+	srv := &http.Server{
+		Addr:    fmt.Sprintf(":%v", configs.Get().APIConfig.HttpPort),
+		Handler: r,
+		BaseContext: func(net.Listener) context.Context {
+			return baseCtx
+		},
+	}
+	// Shutdown 不等待 websocket 等被接管的连接，订阅在开始关闭时即结束，客户端重连到其他实例
+	srv.RegisterOnShutdown(func() {
+		close(shutdown)
+	})
+	return &httpServer{srv: srv, cancel: cancel}
+}
+
+func (s *httpServer) name() string {
+	return "http"
+}
+
+func (s *httpServer) addr() string {
+	return s.srv.Addr
+}
+
+func (s *httpServer) serve(lis net.Listener) error {
+	if err := s.srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// markNotReady /readyz 直接读取 App.Ready，无需处理
+func (s *httpServer) markNotReady() {}
+
+func (s *httpServer) stop(ctx context.Context) {
+	if err := s.srv.Shutdown(ctx); err != nil {
+		logrus.Warnf("http server drain timed out, cancelling in-flight requests: %v", err)
+		s.cancel()
+		_ = s.srv.Close()
+	}
+}
+
+func (a *App) graphqlHandler(shutdown <-chan struct{}) gin.HandlerFunc {
+	client := a.Client
+	// 初始化 graphql server，与 handler.NewDefaultServer 相同，websocket 建立连接时校验 token
+	srv := handler.New(graphql.NewSchema(client, a.Broker))
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              middlewares.WebsocketInit,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New(1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New(100)})
+	srv.Use(metrics.GraphQL{})
+	srv.Use(tracing.GraphQL{})
+	// 查询发往只读副本，变更与订阅在主库执行
+	srv.AroundOperations(func(ctx context.Context, next gqlgen.OperationHandler) gqlgen.ResponseHandler {
+		oc := gqlgen.GetOperationContext(ctx)
+		if oc.Operation != nil && oc.Operation.Operation == ast.Query && oc.Headers.Get(tools.ReadPrimaryHeader) != "true" {
+			ctx = db.WithReadReplica(ctx)
+		}
+		return next(ctx)
+	})
+	// 自定义事务隔离等级
+	srv.Use(entgql.Transactioner{
+		TxOpener: entgql.TxOpenerFunc(func(ctx context.Context) (context.Context, driver.Tx, error) {
+			tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+			if err != nil {
+				return nil, nil, err
+			}
+			ctx = ent.NewTxContext(ctx, tx)
+			ctx = ent.NewContext(ctx, tx.Client())
+			return ctx, tx, nil
+		}),
+	})
+	// 接上 cookie 校验中间件，登录、注册与 federation 的 _service 查询不需要登录
+	srv.Use(middlewares.NewAuthenticationMiddleware("login", "register", "_service"))
+	return func(c *gin.Context) {
+		if c.IsWebsocket() {
+			// 开始关闭时结束订阅
+			ctx, cancel := context.WithCancel(c.Request.Context())
+			defer cancel()
+			go func() {
+				select {
+				case <-shutdown:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			c.Request = c.Request.WithContext(ctx)
+		} else {
+			c.Writer.Header().Set("Content-Type", "application/json")
+		}
+		srv.ServeHTTP(c.Writer, c.Request)
+	}
+}
+
+func playgroundHandler() gin.HandlerFunc {
+	srv := playground.Handler("Test", "/cas/graphql")
+	return func(c *gin.Context) {
+		srv.ServeHTTP(c.Writer, c.Request)
+	}
+}
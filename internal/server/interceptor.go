@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/internal/db"
+	"github.com/stark-sim/cas/pkg/abac"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/grpc/servers"
+	"github.com/stark-sim/cas/pkg/ratelimit"
+	"github.com/stark-sim/cas/tools"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"strings"
+	"time"
+)
+
+// gRPC metadata 的 key 均为小写
+var (
+	requestIDMetadata   = strings.ToLower(tools.RequestIDHeader)
+	readPrimaryMetadata = strings.ToLower(tools.ReadPrimaryHeader)
+)
+
+/*
+requestMetaInterceptor 记录调用方 IP、请求 ID 与 token 中的用户到 ctx 中，供审计日志使用
+*/
+func requestMetaInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(withRequestMeta(ctx), req)
+}
+
+// requestMetaStreamInterceptor 与 requestMetaInterceptor 相同，用于流式方法
+func requestMetaStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &serverStream{ServerStream: ss, ctx: withRequestMeta(ss.Context())})
+}
+
+func withRequestMeta(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	requestID := firstMetadata(md, requestIDMetadata)
+	if requestID == "" {
+		requestID = tools.NewRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadata, requestID))
+	clientIP := strings.TrimSpace(strings.Split(firstMetadata(md, "x-forwarded-for"), ",")[0])
+	if clientIP == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			clientIP = p.Addr.String()
+			if host, _, err := net.SplitHostPort(clientIP); err == nil {
+				clientIP = host
+			}
+		}
+	}
+	ctx = tools.WithRequestMeta(ctx, clientIP, requestID)
+	// 与 graphql 调用 gRPC 时的约定一致，token 放在 metadata 的 token 中
+	if token := firstMetadata(md, "token"); token != "" {
+		if claims, err := tools.ParseToken(token); err == nil {
+			ctx = context.WithValue(ctx, tools.UserIDKey, claims.UserID)
+		}
+	}
+	return ctx
+}
+
+// serverStream 替换流的 ctx
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+/*
+loggingInterceptor 每次调用结束后输出一行日志，需放在 requestMetaInterceptor 之后
+*/
+func loggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+	entry := logrus.WithContext(ctx).WithFields(logrus.Fields{
+		"method":    info.FullMethod,
+		"code":      status.Code(err).String(),
+		"latency":   time.Since(start).String(),
+		"client_ip": tools.GetClientIP(ctx),
+	})
+	if err != nil {
+		entry.Warnf("grpc call failed: %v", err)
+	} else {
+		entry.Info("grpc call")
+	}
+	return res, err
+}
+
+/*
+readReplicaInterceptor servers.ReadMethods 的查询发往只读副本，
+调用方在 metadata 中要求读主库时除外
+*/
+func readReplicaInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if tools.IsOneOf(info.FullMethod, servers.ReadMethods...) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if firstMetadata(md, readPrimaryMetadata) != "true" {
+			ctx = db.WithReadReplica(ctx)
+		}
+	}
+	return handler(ctx, req)
+}
+
+// rateLimitInterceptor 按调用方 IP 限流，需放在 requestMetaInterceptor 之后
+func rateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !limiter.Allow(tools.GetClientIP(ctx)) {
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(ctx, req)
+	}
+}
+
+/*
+authInterceptor servers.Permissions 中的方法需要登录，且访问策略允许，需放在 requestMetaInterceptor 之后
+*/
+func authInterceptor(engine *abac.Engine) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if permission, ok := servers.Permissions[info.FullMethod]; ok {
+			if err := authorize(ctx, engine, permission); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authStreamInterceptor 与 authInterceptor 相同，用于流式方法，需放在 requestMetaStreamInterceptor 之后
+func authStreamInterceptor(engine *abac.Engine) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if permission, ok := servers.Permissions[info.FullMethod]; ok {
+			if err := authorize(ss.Context(), engine, permission); err != nil {
+				return err
+			}
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorize token 无效时 requestMetaInterceptor 不会写入用户，同样视为未登录
+func authorize(ctx context.Context, engine *abac.Engine, permission servers.Permission) error {
+	userID := tools.GetUserID(ctx)
+	if userID == 0 {
+		return status.Error(codes.Unauthenticated, "token is required")
+	}
+	decision, err := engine.Decide(ctx, abac.Request{SubjectID: userID, Action: permission.Action, Resource: permission.Resource})
+	if err != nil {
+		return err
+	}
+	if !decision.Allowed {
+		return status.Errorf(codes.PermissionDenied, "%s %s is not allowed", permission.Action, permission.Resource)
+	}
+	return nil
+}
+
+func firstMetadata(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+/*
+txInterceptor 为 servers.WriteMethods 开启事务，与 graphql 的 entgql.Transactioner 一致
+handler 返回错误时回滚，审计日志与 outbox 事件随变更一并提交或回滚
+*/
+func txInterceptor(client *ent.Client) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !tools.IsOneOf(info.FullMethod, servers.WriteMethods...) {
+			return handler(ctx, req)
+		}
+		tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+		if err != nil {
+			return nil, err
+		}
+		ctx = ent.NewTxContext(ctx, tx)
+		ctx = ent.NewContext(ctx, tx.Client())
+		res, err := handler(ctx, req)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if err = tx.Commit(); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stark-sim/cas/configs"
+	"reflect"
+	"sync/atomic"
+)
+
+// allowOrigins 允许的 Origin，nil 表示允许任意来源
+type allowOrigins map[string]bool
+
+func newAllowOrigins(origins []string) allowOrigins {
+	if len(origins) == 0 {
+		return nil
+	}
+	res := make(allowOrigins, len(origins))
+	for _, origin := range origins {
+		if origin == "*" {
+			return nil
+		}
+		res[origin] = true
+	}
+	return res
+}
+
+/*
+CORS 解决跨域中间件，允许的来源取自 cors.allow_origins，配置变更后立即生效
+*/
+func CORS() gin.HandlerFunc {
+	var origins atomic.Pointer[allowOrigins]
+	initial := newAllowOrigins(configs.Get().CORSConfig.AllowOrigins)
+	origins.Store(&initial)
+	configs.OnChange(func(old *configs.Config, next *configs.Config) {
+		if !reflect.DeepEqual(old.CORSConfig, next.CORSConfig) {
+			allowed := newAllowOrigins(next.CORSConfig.AllowOrigins)
+			origins.Store(&allowed)
+		}
+	})
+	return func(c *gin.Context) {
+		allowed := *origins.Load()
+		if allowed == nil {
+			c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			// 按请求的 Origin 返回，缓存需区分 Origin
+			c.Writer.Header().Add("Vary", "Origin")
+			if origin := c.GetHeader("Origin"); allowed[origin] {
+				c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+			}
+		}
+		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+		c.Writer.Header().Add("Access-Control-Allow-Headers", "Content-Type, Access-Token, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-Request-ID, X-Read-Primary")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "OPTIONS, GET, POST, PUT, DELETE")
+		// 不是每一个请求都要返回 json
+		//c.Writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if c.Request.Method == "OPTIONS" {
+			c.AbortWithStatus(204)
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+/*
+Logger 每个请求结束后输出一行日志，代替 gin 默认的文本日志
+需放在 RequestMeta 之后，日志才会带上请求 ID
+*/
+func Logger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		entry := logrus.WithContext(c.Request.Context()).WithFields(logrus.Fields{
+			"method":    c.Request.Method,
+			"path":      c.Request.URL.Path,
+			"status":    c.Writer.Status(),
+			"latency":   time.Since(start).String(),
+			"client_ip": c.ClientIP(),
+		})
+		if len(c.Errors) > 0 {
+			entry.Error(c.Errors.String())
+			return
+		}
+		entry.Info("http request")
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stark-sim/cas/pkg/metrics"
+	"time"
+)
+
+/*
+Metrics 按路由模板记录 HTTP 请求的延迟与状态码，websocket 连接不记录
+*/
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.IsWebsocket() {
+			c.Next()
+			return
+		}
+		start := time.Now()
+		c.Next()
+		metrics.ObserveHTTP(c.FullPath(), c.Request.Method, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stark-sim/cas/tools"
+)
+
+/*
+RequestMeta 记录客户端 IP 与请求 ID 到 ctx 中，供审计日志使用
+*/
+func RequestMeta() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(tools.RequestIDHeader)
+		if requestID == "" {
+			requestID = tools.NewRequestID()
+		}
+		c.Writer.Header().Set(tools.RequestIDHeader, requestID)
+		ctx := tools.WithRequestMeta(c.Request.Context(), c.ClientIP(), requestID)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/configs"
+	"github.com/stark-sim/cas/internal/db"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ratelimit"
+	"github.com/stark-sim/cas/pkg/watch"
+	"golang.org/x/sync/errgroup"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultDrainTimeout 未配置时等待进行中的请求完成的时长
+const DefaultDrainTimeout = 15 * time.Second
+
+// App 同一进程内 HTTP 与 gRPC 共享的数据库连接与变更通知
+type App struct {
+	Client *ent.Client
+	Broker *watch.Broker
+	// HTTP 与 gRPC 共用的按客户端 IP 限流，限额随配置变更
+	Limiter *ratelimit.Limiter
+	// 服务启动后为 true，开始关闭前置为 false
+	ready atomic.Bool
+}
+
+func New() (*App, error) {
+	client, err := db.NewDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed at creating ent client: %w", err)
+	}
+	// 变更订阅，本进程的变更即时通知，其他进程的变更靠轮询发现
+	broker := watch.NewBroker(configs.Get().WatchConfig.Lookback, configs.Get().WatchConfig.PollInterval)
+	watch.Register(client, broker)
+	limit := configs.Get().RateLimitConfig
+	limiter := ratelimit.NewLimiter(limit.RequestsPerSecond, limit.Burst)
+	configs.OnChange(func(old *configs.Config, next *configs.Config) {
+		if old.RateLimitConfig != next.RateLimitConfig {
+			limiter.Update(next.RateLimitConfig.RequestsPerSecond, next.RateLimitConfig.Burst)
+			logrus.Printf("applied rate limit config: %+v", next.RateLimitConfig)
+		}
+	})
+	return &App{Client: client, Broker: broker, Limiter: limiter}, nil
+}
+
+// Ready 是否可以接收流量
+func (a *App) Ready() bool {
+	return a.ready.Load()
+}
+
+// Close 服务停止后关闭数据库连接
+func (a *App) Close() error {
+	a.Broker.Close()
+	return a.Client.Close()
+}
+
+/*
+Serve 启动 HTTP 与（或）gRPC 服务，runWorkers 为 true 时同时运行后台任务，直到 ctx 结束或任一服务退出
+任一服务出错时其余服务一并停止并返回该错误，进程应以非零状态退出
+*/
+func (a *App) Serve(ctx context.Context, serveHTTP, serveGRPC, runWorkers bool) error {
+	group, ctx := errgroup.WithContext(ctx)
+	var servers []server
+	if serveHTTP {
+		servers = append(servers, a.newHTTPServer())
+	}
+	if serveGRPC {
+		s, err := a.newGRPCServer(ctx)
+		if err != nil {
+			return err
+		}
+		servers = append(servers, s)
+	}
+	// 全部端口监听成功后才开始服务，任一端口被占用时直接返回
+	listeners := make([]net.Listener, 0, len(servers))
+	for _, s := range servers {
+		lis, err := net.Listen("tcp", s.addr())
+		if err != nil {
+			for _, v := range listeners {
+				_ = v.Close()
+			}
+			return fmt.Errorf("%s server failed at listening on %s: %w", s.name(), s.addr(), err)
+		}
+		listeners = append(listeners, lis)
+	}
+	// 后台任务与运行哪个服务无关，多个进程同时运行也不会重复处理
+	if runWorkers {
+		a.startWorkers(ctx)
+	}
+	for i, s := range servers {
+		s, lis := s, listeners[i]
+		group.Go(func() error {
+			logrus.Printf("starting %s server at %s", s.name(), lis.Addr())
+			if err := s.serve(lis); err != nil {
+				return fmt.Errorf("%s server stopped: %w", s.name(), err)
+			}
+			// 未经 stop 而退出同样视为故障
+			if ctx.Err() == nil {
+				return fmt.Errorf("%s server stopped unexpectedly", s.name())
+			}
+			return nil
+		})
+	}
+	// 端口已在监听，连接会排队等待 serve 接收
+	a.ready.Store(true)
+	group.Go(func() error {
+		<-ctx.Done()
+		a.shutdown(servers)
+		return nil
+	})
+	return group.Wait()
+}
+
+/*
+shutdown 先标记为未就绪并等待负载均衡摘除流量，再停止接收新请求
+进行中的请求在 drain_timeout 内完成，超时后强制关闭，未完成的事务随请求取消而回滚
+*/
+func (a *App) shutdown(servers []server) {
+	a.ready.Store(false)
+	for _, s := range servers {
+		s.markNotReady()
+	}
+	conf := configs.Get().APIConfig
+	if conf.ShutdownDelay > 0 {
+		logrus.Printf("marked not ready, waiting %v before shutting down", conf.ShutdownDelay)
+		time.Sleep(conf.ShutdownDelay)
+	}
+	drainTimeout := conf.DrainTimeout
+	if drainTimeout <= 0 {
+		drainTimeout = DefaultDrainTimeout
+	}
+	logrus.Printf("shutting down, draining in-flight requests for up to %v", drainTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+	// 订阅流不会自行结束，先关闭才能优雅退出
+	a.Broker.Close()
+	wg := sync.WaitGroup{}
+	for _, s := range servers {
+		wg.Add(1)
+		go func(s server) {
+			defer wg.Done()
+			s.stop(ctx)
+		}(s)
+	}
+	wg.Wait()
+}
+
+// server HTTP 与 gRPC 服务的统一生命周期
+type server interface {
+	name() string
+	addr() string
+	// serve 在 Serve 打开的 lis 上阻塞直到服务停止，stop 引起的退出返回 nil
+	serve(lis net.Listener) error
+	// markNotReady 开始关闭时调用，健康检查随即返回未就绪
+	markNotReady()
+	// stop 等待进行中的请求完成，ctx 结束时强制关闭
+	stop(ctx context.Context)
+}
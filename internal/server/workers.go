@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+	"github.com/stark-sim/cas/configs"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/outbox"
+	"github.com/stark-sim/cas/pkg/rbac"
+	"github.com/stark-sim/cas/pkg/webhook"
+)
+
+// startWorkers 启动后台任务，ctx 结束时退出
+func (a *App) startWorkers(ctx context.Context) {
+	// 后台清理过期的限时授权
+	rbac.StartSweeper(ctx, a.Client, configs.Get().RoleConfig.SweepInterval)
+	// 后台发布 outbox 中的事件，并投递回调
+	outbox.NewRelayer(a.Client, outboxPublisher(a.Client, configs.Get().OutboxConfig), configs.Get().OutboxConfig.MaxAttempts).
+		Start(ctx, configs.Get().OutboxConfig.RelayInterval)
+	webhook.NewDeliverer(a.Client, configs.Get().WebhookConfig.MaxAttempts, configs.Get().WebhookConfig.Timeout).
+		Start(ctx, configs.Get().WebhookConfig.DeliverInterval)
+}
+
+// outboxPublisher 事件生成回调的投递记录，配置了文件时同时写入文件
+func outboxPublisher(client *ent.Client, conf configs.OutboxConfig) outbox.Publisher {
+	publishers := outbox.Publishers{&webhook.Publisher{Client: client}}
+	if conf.File != "" {
+		publishers = append(publishers, &outbox.FilePublisher{Path: conf.File})
+	}
+	return publishers
+}
@@ -3,6 +3,209 @@ package main
 // 当有多个可执行文件时，应该将 main.go 放在 /cmd/myapp/ 目录下
 // 而对于微服务形式来说，只需要放在根目录就够直观了
 
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/configs"
+	"github.com/stark-sim/cas/internal/db"
+	"github.com/stark-sim/cas/internal/server"
+	"github.com/stark-sim/cas/pkg/rbac"
+	"github.com/stark-sim/cas/pkg/tracing"
+	"github.com/stark-sim/cas/tools"
+	"os"
+	"os/signal"
+	"reflect"
+	"runtime/debug"
+	"syscall"
+	"time"
+)
+
+// version 构建时通过 -ldflags "-X main.version=v1.2.3" 写入
+var version = "dev"
+
+const usage = `usage: cas <command> [--config path]
+
+commands:
+  serve        run HTTP and gRPC servers in one process
+  serve-http   run the HTTP (GraphQL) server only
+  serve-grpc   run the gRPC server only
+  check        check role assignments whose user or role is missing,
+               exits with 2 when any is found; does not migrate and
+               fails when the database schema is not up to date
+  migrate      apply database migrations and exit
+  version      print version and exit
+
+options:
+  --config     config file, defaults to $CAS_CONFIG or ./config.yaml;
+               any field can be overridden by CAS_* environment variables,
+               e.g. CAS_DB_PASSWORD for db.password
+  --workers    run background workers (grant sweeper, outbox relay, webhook
+               deliverer) with any serve command, defaults to true
+  --repair     with check, soft delete the orphaned role assignments
+`
+
 func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	command, args := os.Args[1], os.Args[2:]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+	}
+	configPath := flags.String("config", "", "config file")
+	runWorkers := flags.Bool("workers", true, "run background workers")
+	repair := flags.Bool("repair", false, "soft delete orphaned role assignments")
+	_ = flags.Parse(args)
+	var err error
+	switch command {
+	case "serve":
+		err = serve(*configPath, true, true, *runWorkers)
+	case "serve-http":
+		err = serve(*configPath, true, false, *runWorkers)
+	case "serve-grpc":
+		err = serve(*configPath, false, true, *runWorkers)
+	case "check":
+		var found int
+		if found, err = check(*configPath, *repair); err == nil && found > 0 && !*repair {
+			// 只检查时发现问题以非零退出，便于在 CI 或定时任务中告警
+			os.Exit(2)
+		}
+	case "migrate":
+		err = migrate(*configPath)
+	case "version":
+		fmt.Println(versionString())
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		logrus.Errorf("cas %s failed: %v", command, err)
+		os.Exit(1)
+	}
+}
+
+// setup 日志依赖配置，先读取配置；任一步失败都不应继续启动
+func setup(configPath string) error {
+	if err := configs.InitConfig(configPath); err != nil {
+		return err
+	}
+	if err := configs.InitLogger(); err != nil {
+		return err
+	}
+	auth := configs.Get().AuthConfig
+	tools.SetSecretKey(auth.SecretKey, auth.PreviousKeys...)
+	// 轮换密钥无需重启
+	configs.OnChange(func(old *configs.Config, next *configs.Config) {
+		if !reflect.DeepEqual(old.AuthConfig, next.AuthConfig) {
+			tools.SetSecretKey(next.AuthConfig.SecretKey, next.AuthConfig.PreviousKeys...)
+			logrus.Printf("applied signing keys from config")
+		}
+	})
+	return tools.Init()
+}
+
+// serve 任一服务退出时整个进程以非零状态退出，由编排系统重启
+func serve(configPath string, serveHTTP, serveGRPC, runWorkers bool) error {
+	if err := setup(configPath); err != nil {
+		return err
+	}
+	shutdownTracing, err := initTracing()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logrus.Warnf("failed at flushing traces: %v", err)
+		}
+	}()
+	if err := db.InitDB(); err != nil {
+		return err
+	}
+	app, err := server.New()
+	if err != nil {
+		return err
+	}
+	defer app.Close()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM)
+	defer stop()
+	return app.Serve(ctx, serveHTTP, serveGRPC, runWorkers)
+}
+
+// initTracing 返回的函数导出尚未发送的 span
+func initTracing() (func(context.Context) error, error) {
+	conf := configs.Get().TracingConfig
+	serviceName := conf.ServiceName
+	if serviceName == "" {
+		serviceName = "cas"
+	}
+	return tracing.Init(context.Background(), tracing.Options{
+		Exporter:    conf.Exporter,
+		Endpoint:    conf.Endpoint,
+		Insecure:    conf.Insecure,
+		File:        conf.File,
+		SampleRatio: conf.SampleRatio,
+		ServiceName: serviceName,
+		Version:     version,
+	})
+}
+
+func migrate(configPath string) error {
+	if err := setup(configPath); err != nil {
+		return err
+	}
+	return db.InitDB()
+}
+
+// check 检查并按需修复孤立的授权，返回发现的数量
+func check(configPath string, repair bool) (int, error) {
+	if err := setup(configPath); err != nil {
+		return 0, err
+	}
+	// 只读取迁移文件，不迁移数据库
+	if err := db.LoadMigrations(); err != nil {
+		return 0, err
+	}
+	client, err := db.NewDBClient()
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+	if err = db.CheckMigrations(context.Background()); err != nil {
+		return 0, fmt.Errorf("database schema does not match, run cas migrate first: %w", err)
+	}
+	orphans, err := rbac.CheckAssignments(context.Background(), client, repair)
+	for _, v := range orphans {
+		logrus.WithFields(logrus.Fields{
+			"user_role":    v.UserRoleID,
+			"user_id":      v.UserID,
+			"role_id":      v.RoleID,
+			"missing_user": v.MissingUser,
+			"missing_role": v.MissingRole,
+		}).Warn("orphaned role assignment")
+	}
+	if err != nil {
+		return len(orphans), fmt.Errorf("failed at checking role assignments: %w", err)
+	}
+	logrus.Printf("found %d orphaned role assignments", len(orphans))
+	return len(orphans), nil
+}
 
+// versionString 附带构建时的提交信息
+func versionString() string {
+	res := "cas " + version
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, v := range info.Settings {
+			if v.Key == "vcs.revision" {
+				res += " (" + v.Value + ")"
+			}
+		}
+		res += " " + info.GoVersion
+	}
+	return res
 }
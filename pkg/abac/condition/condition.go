@@ -0,0 +1,81 @@
+package condition
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+// 表达式中可用的三类属性，均为 map(string, dyn)
+const (
+	Subject     = "subject"
+	Resource    = "resource"
+	Environment = "environment"
+)
+
+var (
+	env     *cel.Env
+	envErr  error
+	envOnce sync.Once
+)
+
+// Env 返回策略条件使用的 CEL 环境
+func Env() (*cel.Env, error) {
+	envOnce.Do(func() {
+		attrs := cel.MapType(cel.StringType, cel.DynType)
+		env, envErr = cel.NewEnv(
+			cel.Variable(Subject, attrs),
+			cel.Variable(Resource, attrs),
+			cel.Variable(Environment, attrs),
+		)
+	})
+	return env, envErr
+}
+
+/*
+Compile 校验并编译条件表达式，表达式结果必须为 bool
+*/
+func Compile(expr string) (cel.Program, error) {
+	if expr == "" {
+		return nil, errors.New("condition is empty")
+	}
+	e, err := Env()
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := e.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("condition must evaluate to bool, got %v", ast.OutputType())
+	}
+	return e.Program(ast)
+}
+
+// Validate 供 ent schema 在保存前校验条件
+func Validate(expr string) error {
+	_, err := Compile(expr)
+	return err
+}
+
+/*
+Eval 执行编译后的条件，非 bool 结果视为错误
+*/
+func Eval(prg cel.Program, subject, resource, environment map[string]interface{}) (bool, error) {
+	out, _, err := prg.Eval(map[string]interface{}{
+		Subject:     subject,
+		Resource:    resource,
+		Environment: environment,
+	})
+	if err != nil {
+		return false, err
+	}
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("condition returned %T instead of bool", out.Value())
+	}
+	return matched, nil
+}
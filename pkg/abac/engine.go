@@ -0,0 +1,216 @@
+package abac
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/pkg/abac/condition"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
+	"github.com/stark-sim/cas/pkg/ent/hook"
+	"github.com/stark-sim/cas/pkg/ent/schema"
+	"github.com/stark-sim/cas/pkg/ent/user"
+	"github.com/stark-sim/cas/pkg/rbac"
+)
+
+// Any 匹配任意动作或资源类型
+const Any = "*"
+
+// programIdleTTL 编译结果闲置超过该时长即清理，其他实例删除或禁用的策略不会再被查询到
+const programIdleTTL = 10 * time.Minute
+
+// Request 一次授权判定的输入
+type Request struct {
+	// SubjectID 为 0 时不加载用户属性
+	SubjectID int64
+	Action    string
+	Resource  string
+	// 调用方提供的附加属性，主体的 id/name/phone/roles 以数据库为准，不可覆盖
+	Subject            map[string]interface{}
+	ResourceAttributes map[string]interface{}
+	Environment        map[string]interface{}
+}
+
+// Decision 判定结果，PolicyIDs 为决定结果的策略
+type Decision struct {
+	Allowed   bool
+	Effect    accesspolicy.Effect
+	PolicyIDs []int64
+}
+
+type program struct {
+	updatedAt time.Time
+	prg       cel.Program
+	// 最近一次使用的时间，UnixNano
+	lastUsed int64
+}
+
+/*
+Engine 策略判定引擎，采用 deny-overrides：
+任一匹配的 DENY 策略即拒绝，否则任一匹配的 ALLOW 策略即允许，都不匹配时默认拒绝
+*/
+type Engine struct {
+	client *ent.Client
+
+	mu       sync.RWMutex
+	programs map[int64]*program
+}
+
+// NewEngine 在 client 上注册 hook，策略变更或删除后丢弃其编译结果
+func NewEngine(client *ent.Client) *Engine {
+	e := &Engine{client: client, programs: make(map[int64]*program)}
+	client.AccessPolicy.Use(hook.On(e.evictHook, ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne))
+	return e
+}
+
+func (e *Engine) Decide(ctx context.Context, req Request) (*Decision, error) {
+	policies, err := e.client.AccessPolicy.Query().Where(
+		accesspolicy.Enabled(true),
+		accesspolicy.ActionIn(req.Action, Any),
+		accesspolicy.ResourceIn(req.Resource, Any),
+	).All(ctx)
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("failed at querying access policies, err: %v", err)
+		return nil, err
+	}
+	subject, err := e.subjectAttributes(ctx, req)
+	if err != nil {
+		// 主体不存在或已删除时按默认拒绝处理
+		if ent.IsNotFound(err) {
+			return &Decision{}, nil
+		}
+		return nil, err
+	}
+	resource := copyAttributes(req.ResourceAttributes)
+	resource["type"] = req.Resource
+	environment := copyAttributes(req.Environment)
+	if _, ok := environment["time"]; !ok {
+		environment["time"] = time.Now()
+	}
+	environment["action"] = req.Action
+
+	decision := &Decision{}
+	var allowIDs, denyIDs []int64
+	for _, p := range policies {
+		matched, err := e.evaluate(p, subject, resource, environment)
+		if err != nil {
+			// 求值失败时 DENY 策略按拒绝处理，ALLOW 策略按不适用处理
+			logrus.WithContext(ctx).Warnf("failed at evaluating access policy %d, err: %v", p.ID, err)
+			matched = p.Effect == accesspolicy.EffectDeny
+		}
+		if !matched {
+			continue
+		}
+		if p.Effect == accesspolicy.EffectDeny {
+			denyIDs = append(denyIDs, p.ID)
+		} else {
+			allowIDs = append(allowIDs, p.ID)
+		}
+	}
+	switch {
+	case len(denyIDs) > 0:
+		decision.Effect = accesspolicy.EffectDeny
+		decision.PolicyIDs = denyIDs
+	case len(allowIDs) > 0:
+		decision.Allowed = true
+		decision.Effect = accesspolicy.EffectAllow
+		decision.PolicyIDs = allowIDs
+	}
+	return decision, nil
+}
+
+func (e *Engine) evaluate(p *ent.AccessPolicy, subject, resource, environment map[string]interface{}) (bool, error) {
+	prg, err := e.program(p)
+	if err != nil {
+		return false, err
+	}
+	return condition.Eval(prg, subject, resource, environment)
+}
+
+// program 取出缓存的编译结果，策略更新后丢弃旧版本重新编译
+func (e *Engine) program(p *ent.AccessPolicy) (cel.Program, error) {
+	now := time.Now()
+	e.mu.RLock()
+	cached, ok := e.programs[p.ID]
+	e.mu.RUnlock()
+	if ok && cached.updatedAt.Equal(p.UpdatedAt) {
+		atomic.StoreInt64(&cached.lastUsed, now.UnixNano())
+		return cached.prg, nil
+	}
+	prg, err := condition.Compile(p.Condition)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.programs, p.ID)
+	e.evictIdle(now)
+	if err != nil {
+		return nil, err
+	}
+	e.programs[p.ID] = &program{updatedAt: p.UpdatedAt, prg: prg, lastUsed: now.UnixNano()}
+	return prg, nil
+}
+
+// evictIdle 清理闲置的编译结果，调用方需持有写锁
+func (e *Engine) evictIdle(now time.Time) {
+	deadline := now.Add(-programIdleTTL).UnixNano()
+	for id, v := range e.programs {
+		if atomic.LoadInt64(&v.lastUsed) < deadline {
+			delete(e.programs, id)
+		}
+	}
+}
+
+// evictHook 变更执行后丢弃受影响策略的编译结果，事务回滚时只是多一次编译
+func (e *Engine) evictHook(next ent.Mutator) ent.Mutator {
+	return hook.AccessPolicyFunc(func(ctx context.Context, m *ent.AccessPolicyMutation) (ent.Value, error) {
+		ids, err := m.IDs(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			return nil, err
+		}
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		e.mu.Lock()
+		for _, id := range ids {
+			delete(e.programs, id)
+		}
+		e.mu.Unlock()
+		return value, nil
+	})
+}
+
+func (e *Engine) subjectAttributes(ctx context.Context, req Request) (map[string]interface{}, error) {
+	attrs := copyAttributes(req.Subject)
+	if req.SubjectID == 0 {
+		return attrs, nil
+	}
+	_user, err := e.client.User.Query().Where(user.ID(req.SubjectID)).First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userRoles, err := rbac.UserRoles(ctx, e.client, _user.ID)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]interface{}, 0, len(userRoles))
+	for _, v := range userRoles {
+		roles = append(roles, v.Name)
+	}
+	attrs["id"] = _user.ID
+	attrs["name"] = _user.Name
+	attrs["phone"] = _user.Phone
+	attrs["roles"] = roles
+	return attrs, nil
+}
+
+func copyAttributes(src map[string]interface{}) map[string]interface{} {
+	dst := make(map[string]interface{}, len(src)+4)
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
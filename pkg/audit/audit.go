@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/auditevent"
+	"github.com/stark-sim/cas/pkg/ent/hook"
+	"github.com/stark-sim/cas/pkg/ent/schema"
+	"github.com/stark-sim/cas/tools"
+)
+
+const deletedAtField = "deleted_at"
+
+var ErrImmutable = errors.New("audit events are immutable")
+
+// 需要审计的实体，关系元组的审计日志 ID 同时作为 rebac 的一致性版本号
+var auditedTypes = []string{ent.TypeUser, ent.TypeRole, ent.TypeUserRole, ent.TypeRelationTuple}
+
+/*
+Register 为 client 注册审计 hook，审计日志与变更写在同一事务中，写入失败时变更一并失败
+*/
+func Register(client *ent.Client) {
+	client.Use(hook.If(Hook(), hook.Condition(func(_ context.Context, m ent.Mutation) bool {
+		return tools.IsOneOf(m.Type(), auditedTypes...)
+	})))
+	// 审计日志只允许新增
+	client.AuditEvent.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne))
+}
+
+// Hook 每次变更后按实体写入一条 AuditEvent
+func Hook() ent.Hook {
+	return schema.RecordHook(func(ctx context.Context, m schema.Mutation) func(context.Context, []int64) error {
+		var before map[string]interface{}
+		if m.Op().Is(ent.OpUpdateOne) {
+			before = oldValues(schema.SkipSoftDelete(ctx), m)
+		}
+		return func(ctx context.Context, ids []int64) error {
+			changes := diff(m, before)
+			builders := make([]*ent.AuditEventCreate, 0, len(ids))
+			for _, id := range ids {
+				builders = append(builders, m.Client().AuditEvent.Create().
+					SetActorID(tools.GetUserID(ctx)).
+					SetOperation(operation(m)).
+					SetEntityType(m.Type()).
+					SetEntityID(id).
+					SetChanges(changes).
+					SetClientIP(tools.GetClientIP(ctx)).
+					SetRequestID(tools.GetRequestID(ctx)))
+			}
+			if err := m.Client().AuditEvent.CreateBulk(builders...).Exec(ctx); err != nil {
+				return fmt.Errorf("failed at writing audit events: %w", err)
+			}
+			return nil
+		}
+	})
+}
+
+// operation 软删除表现为把 deleted_at 更新为非零值
+func operation(m ent.Mutation) auditevent.Operation {
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		return auditevent.OperationCreate
+	case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		return auditevent.OperationPurge
+	}
+	if v, ok := m.Field(deletedAtField); ok {
+		if t, ok := v.(time.Time); ok && !t.Equal(tools.ZeroTime) {
+			return auditevent.OperationDelete
+		}
+	}
+	return auditevent.OperationUpdate
+}
+
+func oldValues(ctx context.Context, m ent.Mutation) map[string]interface{} {
+	res := make(map[string]interface{})
+	for _, f := range append(m.Fields(), m.ClearedFields()...) {
+		if v, err := m.OldField(ctx, f); err == nil {
+			res[f] = v
+		}
+	}
+	return res
+}
+
+// diff 字段为 {before, after}，批量更新时没有 before；边为 {added, removed}
+func diff(m ent.Mutation, before map[string]interface{}) map[string]interface{} {
+	changes := make(map[string]interface{})
+	for _, f := range m.Fields() {
+		after, _ := m.Field(f)
+		change := map[string]interface{}{"after": jsonValue(after)}
+		if old, ok := before[f]; ok {
+			change["before"] = jsonValue(old)
+		}
+		changes[f] = change
+	}
+	for _, f := range m.ClearedFields() {
+		change := map[string]interface{}{"after": nil}
+		if old, ok := before[f]; ok {
+			change["before"] = jsonValue(old)
+		}
+		changes[f] = change
+	}
+	edges := make(map[string]map[string]interface{})
+	for _, e := range m.AddedEdges() {
+		edges[e] = map[string]interface{}{"added": jsonValue(m.AddedIDs(e))}
+	}
+	for _, e := range m.RemovedEdges() {
+		if _, ok := edges[e]; !ok {
+			edges[e] = make(map[string]interface{})
+		}
+		edges[e]["removed"] = jsonValue(m.RemovedIDs(e))
+	}
+	for e, change := range edges {
+		changes[e] = change
+	}
+	return changes
+}
+
+// jsonValue int64 转为字符串保存，JSON 数字解码为 float64，超过 2^53 的 snowflake ID 会丢失精度
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case []int64:
+		res := make([]string, 0, len(v))
+		for _, id := range v {
+			res = append(res, strconv.FormatInt(id, 10))
+		}
+		return res
+	case []ent.Value:
+		res := make([]interface{}, 0, len(v))
+		for _, id := range v {
+			res = append(res, jsonValue(id))
+		}
+		return res
+	}
+	return v
+}
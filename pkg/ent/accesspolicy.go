@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
+)
+
+// AccessPolicy is the model entity for the AccessPolicy schema.
+type AccessPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy int64 `json:"created_by"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy int64 `json:"updated_by"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Effect holds the value of the "effect" field.
+	Effect accesspolicy.Effect `json:"effect,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Resource holds the value of the "resource" field.
+	Resource string `json:"resource,omitempty"`
+	// Condition holds the value of the "condition" field.
+	Condition string `json:"condition,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accesspolicy.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case accesspolicy.FieldID, accesspolicy.FieldCreatedBy, accesspolicy.FieldUpdatedBy:
+			values[i] = new(sql.NullInt64)
+		case accesspolicy.FieldName, accesspolicy.FieldDescription, accesspolicy.FieldEffect, accesspolicy.FieldAction, accesspolicy.FieldResource, accesspolicy.FieldCondition:
+			values[i] = new(sql.NullString)
+		case accesspolicy.FieldCreatedAt, accesspolicy.FieldUpdatedAt, accesspolicy.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AccessPolicy", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessPolicy fields.
+func (ap *AccessPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accesspolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ap.ID = int64(value.Int64)
+		case accesspolicy.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ap.CreatedBy = value.Int64
+			}
+		case accesspolicy.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ap.UpdatedBy = value.Int64
+			}
+		case accesspolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ap.CreatedAt = value.Time
+			}
+		case accesspolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ap.UpdatedAt = value.Time
+			}
+		case accesspolicy.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ap.DeletedAt = value.Time
+			}
+		case accesspolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ap.Name = value.String
+			}
+		case accesspolicy.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ap.Description = value.String
+			}
+		case accesspolicy.FieldEffect:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field effect", values[i])
+			} else if value.Valid {
+				ap.Effect = accesspolicy.Effect(value.String)
+			}
+		case accesspolicy.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ap.Action = value.String
+			}
+		case accesspolicy.FieldResource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource", values[i])
+			} else if value.Valid {
+				ap.Resource = value.String
+			}
+		case accesspolicy.FieldCondition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field condition", values[i])
+			} else if value.Valid {
+				ap.Condition = value.String
+			}
+		case accesspolicy.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ap.Enabled = value.Bool
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AccessPolicy.
+// Note that you need to call AccessPolicy.Unwrap() before calling this method if this AccessPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ap *AccessPolicy) Update() *AccessPolicyUpdateOne {
+	return NewAccessPolicyClient(ap.config).UpdateOne(ap)
+}
+
+// Unwrap unwraps the AccessPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ap *AccessPolicy) Unwrap() *AccessPolicy {
+	_tx, ok := ap.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessPolicy is not a transactional entity")
+	}
+	ap.config.driver = _tx.drv
+	return ap
+}
+
+// String implements the fmt.Stringer.
+func (ap *AccessPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ap.ID))
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", ap.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(fmt.Sprintf("%v", ap.UpdatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ap.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ap.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(ap.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ap.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ap.Description)
+	builder.WriteString(", ")
+	builder.WriteString("effect=")
+	builder.WriteString(fmt.Sprintf("%v", ap.Effect))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(ap.Action)
+	builder.WriteString(", ")
+	builder.WriteString("resource=")
+	builder.WriteString(ap.Resource)
+	builder.WriteString(", ")
+	builder.WriteString("condition=")
+	builder.WriteString(ap.Condition)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ap.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IsEntity implement fedruntime.Entity
+func (ap AccessPolicy) IsEntity() {}
+
+// AccessPolicies is a parsable slice of AccessPolicy.
+type AccessPolicies []*AccessPolicy
+
+func (ap AccessPolicies) config(cfg config) {
+	for _i := range ap {
+		ap[_i].config = cfg
+	}
+}
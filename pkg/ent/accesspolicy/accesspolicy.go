@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accesspolicy
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the accesspolicy type in the database.
+	Label = "access_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldEffect holds the string denoting the effect field in the database.
+	FieldEffect = "effect"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldResource holds the string denoting the resource field in the database.
+	FieldResource = "resource"
+	// FieldCondition holds the string denoting the condition field in the database.
+	FieldCondition = "condition"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// Table holds the table name of the accesspolicy in the database.
+	Table = "access_policies"
+)
+
+// Columns holds all SQL columns for accesspolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedBy,
+	FieldUpdatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldName,
+	FieldDescription,
+	FieldEffect,
+	FieldAction,
+	FieldResource,
+	FieldCondition,
+	FieldEnabled,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/stark-sim/cas/pkg/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy int64
+	// DefaultUpdatedBy holds the default value on creation for the "updated_by" field.
+	DefaultUpdatedBy int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
+	DefaultDeletedAt time.Time
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultDescription holds the default value on creation for the "description" field.
+	DefaultDescription string
+	// DefaultAction holds the default value on creation for the "action" field.
+	DefaultAction string
+	// DefaultResource holds the default value on creation for the "resource" field.
+	DefaultResource string
+	// DefaultCondition holds the default value on creation for the "condition" field.
+	DefaultCondition string
+	// ConditionValidator is a validator for the "condition" field. It is called by the builders before save.
+	ConditionValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() int64
+)
+
+// Effect defines the type for the "effect" enum field.
+type Effect string
+
+// EffectAllow is the default value of the Effect enum.
+const DefaultEffect = EffectAllow
+
+// Effect values.
+const (
+	EffectAllow Effect = "ALLOW"
+	EffectDeny  Effect = "DENY"
+)
+
+func (e Effect) String() string {
+	return string(e)
+}
+
+// EffectValidator is a validator for the "effect" field enum values. It is called by the builders before save.
+func EffectValidator(e Effect) error {
+	switch e {
+	case EffectAllow, EffectDeny:
+		return nil
+	default:
+		return fmt.Errorf("accesspolicy: invalid enum value for effect field: %q", e)
+	}
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Effect) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Effect) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Effect(str)
+	if err := EffectValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Effect", str)
+	}
+	return nil
+}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
+)
+
+// AccessPolicyCreate is the builder for creating a AccessPolicy entity.
+type AccessPolicyCreate struct {
+	config
+	mutation *AccessPolicyMutation
+	hooks    []Hook
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (apc *AccessPolicyCreate) SetCreatedBy(i int64) *AccessPolicyCreate {
+	apc.mutation.SetCreatedBy(i)
+	return apc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableCreatedBy(i *int64) *AccessPolicyCreate {
+	if i != nil {
+		apc.SetCreatedBy(*i)
+	}
+	return apc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (apc *AccessPolicyCreate) SetUpdatedBy(i int64) *AccessPolicyCreate {
+	apc.mutation.SetUpdatedBy(i)
+	return apc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableUpdatedBy(i *int64) *AccessPolicyCreate {
+	if i != nil {
+		apc.SetUpdatedBy(*i)
+	}
+	return apc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (apc *AccessPolicyCreate) SetCreatedAt(t time.Time) *AccessPolicyCreate {
+	apc.mutation.SetCreatedAt(t)
+	return apc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableCreatedAt(t *time.Time) *AccessPolicyCreate {
+	if t != nil {
+		apc.SetCreatedAt(*t)
+	}
+	return apc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (apc *AccessPolicyCreate) SetUpdatedAt(t time.Time) *AccessPolicyCreate {
+	apc.mutation.SetUpdatedAt(t)
+	return apc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableUpdatedAt(t *time.Time) *AccessPolicyCreate {
+	if t != nil {
+		apc.SetUpdatedAt(*t)
+	}
+	return apc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (apc *AccessPolicyCreate) SetDeletedAt(t time.Time) *AccessPolicyCreate {
+	apc.mutation.SetDeletedAt(t)
+	return apc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableDeletedAt(t *time.Time) *AccessPolicyCreate {
+	if t != nil {
+		apc.SetDeletedAt(*t)
+	}
+	return apc
+}
+
+// SetName sets the "name" field.
+func (apc *AccessPolicyCreate) SetName(s string) *AccessPolicyCreate {
+	apc.mutation.SetName(s)
+	return apc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableName(s *string) *AccessPolicyCreate {
+	if s != nil {
+		apc.SetName(*s)
+	}
+	return apc
+}
+
+// SetDescription sets the "description" field.
+func (apc *AccessPolicyCreate) SetDescription(s string) *AccessPolicyCreate {
+	apc.mutation.SetDescription(s)
+	return apc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableDescription(s *string) *AccessPolicyCreate {
+	if s != nil {
+		apc.SetDescription(*s)
+	}
+	return apc
+}
+
+// SetEffect sets the "effect" field.
+func (apc *AccessPolicyCreate) SetEffect(a accesspolicy.Effect) *AccessPolicyCreate {
+	apc.mutation.SetEffect(a)
+	return apc
+}
+
+// SetNillableEffect sets the "effect" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableEffect(a *accesspolicy.Effect) *AccessPolicyCreate {
+	if a != nil {
+		apc.SetEffect(*a)
+	}
+	return apc
+}
+
+// SetAction sets the "action" field.
+func (apc *AccessPolicyCreate) SetAction(s string) *AccessPolicyCreate {
+	apc.mutation.SetAction(s)
+	return apc
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableAction(s *string) *AccessPolicyCreate {
+	if s != nil {
+		apc.SetAction(*s)
+	}
+	return apc
+}
+
+// SetResource sets the "resource" field.
+func (apc *AccessPolicyCreate) SetResource(s string) *AccessPolicyCreate {
+	apc.mutation.SetResource(s)
+	return apc
+}
+
+// SetNillableResource sets the "resource" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableResource(s *string) *AccessPolicyCreate {
+	if s != nil {
+		apc.SetResource(*s)
+	}
+	return apc
+}
+
+// SetCondition sets the "condition" field.
+func (apc *AccessPolicyCreate) SetCondition(s string) *AccessPolicyCreate {
+	apc.mutation.SetCondition(s)
+	return apc
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableCondition(s *string) *AccessPolicyCreate {
+	if s != nil {
+		apc.SetCondition(*s)
+	}
+	return apc
+}
+
+// SetEnabled sets the "enabled" field.
+func (apc *AccessPolicyCreate) SetEnabled(b bool) *AccessPolicyCreate {
+	apc.mutation.SetEnabled(b)
+	return apc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableEnabled(b *bool) *AccessPolicyCreate {
+	if b != nil {
+		apc.SetEnabled(*b)
+	}
+	return apc
+}
+
+// SetID sets the "id" field.
+func (apc *AccessPolicyCreate) SetID(i int64) *AccessPolicyCreate {
+	apc.mutation.SetID(i)
+	return apc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (apc *AccessPolicyCreate) SetNillableID(i *int64) *AccessPolicyCreate {
+	if i != nil {
+		apc.SetID(*i)
+	}
+	return apc
+}
+
+// Mutation returns the AccessPolicyMutation object of the builder.
+func (apc *AccessPolicyCreate) Mutation() *AccessPolicyMutation {
+	return apc.mutation
+}
+
+// Save creates the AccessPolicy in the database.
+func (apc *AccessPolicyCreate) Save(ctx context.Context) (*AccessPolicy, error) {
+	if err := apc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*AccessPolicy, AccessPolicyMutation](ctx, apc.sqlSave, apc.mutation, apc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (apc *AccessPolicyCreate) SaveX(ctx context.Context) *AccessPolicy {
+	v, err := apc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apc *AccessPolicyCreate) Exec(ctx context.Context) error {
+	_, err := apc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apc *AccessPolicyCreate) ExecX(ctx context.Context) {
+	if err := apc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apc *AccessPolicyCreate) defaults() error {
+	if _, ok := apc.mutation.CreatedBy(); !ok {
+		v := accesspolicy.DefaultCreatedBy
+		apc.mutation.SetCreatedBy(v)
+	}
+	if _, ok := apc.mutation.UpdatedBy(); !ok {
+		v := accesspolicy.DefaultUpdatedBy
+		apc.mutation.SetUpdatedBy(v)
+	}
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		if accesspolicy.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesspolicy.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesspolicy.DefaultCreatedAt()
+		apc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := apc.mutation.UpdatedAt(); !ok {
+		if accesspolicy.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesspolicy.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesspolicy.DefaultUpdatedAt()
+		apc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := apc.mutation.DeletedAt(); !ok {
+		v := accesspolicy.DefaultDeletedAt
+		apc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := apc.mutation.Name(); !ok {
+		v := accesspolicy.DefaultName
+		apc.mutation.SetName(v)
+	}
+	if _, ok := apc.mutation.Description(); !ok {
+		v := accesspolicy.DefaultDescription
+		apc.mutation.SetDescription(v)
+	}
+	if _, ok := apc.mutation.Effect(); !ok {
+		v := accesspolicy.DefaultEffect
+		apc.mutation.SetEffect(v)
+	}
+	if _, ok := apc.mutation.Action(); !ok {
+		v := accesspolicy.DefaultAction
+		apc.mutation.SetAction(v)
+	}
+	if _, ok := apc.mutation.Resource(); !ok {
+		v := accesspolicy.DefaultResource
+		apc.mutation.SetResource(v)
+	}
+	if _, ok := apc.mutation.Condition(); !ok {
+		v := accesspolicy.DefaultCondition
+		apc.mutation.SetCondition(v)
+	}
+	if _, ok := apc.mutation.Enabled(); !ok {
+		v := accesspolicy.DefaultEnabled
+		apc.mutation.SetEnabled(v)
+	}
+	if _, ok := apc.mutation.ID(); !ok {
+		if accesspolicy.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized accesspolicy.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := accesspolicy.DefaultID()
+		apc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apc *AccessPolicyCreate) check() error {
+	if _, ok := apc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "AccessPolicy.created_by"`)}
+	}
+	if _, ok := apc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "AccessPolicy.updated_by"`)}
+	}
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessPolicy.created_at"`)}
+	}
+	if _, ok := apc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AccessPolicy.updated_at"`)}
+	}
+	if _, ok := apc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "AccessPolicy.deleted_at"`)}
+	}
+	if _, ok := apc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AccessPolicy.name"`)}
+	}
+	if _, ok := apc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "AccessPolicy.description"`)}
+	}
+	if _, ok := apc.mutation.Effect(); !ok {
+		return &ValidationError{Name: "effect", err: errors.New(`ent: missing required field "AccessPolicy.effect"`)}
+	}
+	if v, ok := apc.mutation.Effect(); ok {
+		if err := accesspolicy.EffectValidator(v); err != nil {
+			return &ValidationError{Name: "effect", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.effect": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AccessPolicy.action"`)}
+	}
+	if _, ok := apc.mutation.Resource(); !ok {
+		return &ValidationError{Name: "resource", err: errors.New(`ent: missing required field "AccessPolicy.resource"`)}
+	}
+	if _, ok := apc.mutation.Condition(); !ok {
+		return &ValidationError{Name: "condition", err: errors.New(`ent: missing required field "AccessPolicy.condition"`)}
+	}
+	if v, ok := apc.mutation.Condition(); ok {
+		if err := accesspolicy.ConditionValidator(v); err != nil {
+			return &ValidationError{Name: "condition", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.condition": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "AccessPolicy.enabled"`)}
+	}
+	return nil
+}
+
+func (apc *AccessPolicyCreate) sqlSave(ctx context.Context) (*AccessPolicy, error) {
+	if err := apc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := apc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, apc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	apc.mutation.id = &_node.ID
+	apc.mutation.done = true
+	return _node, nil
+}
+
+func (apc *AccessPolicyCreate) createSpec() (*AccessPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessPolicy{config: apc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: accesspolicy.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: accesspolicy.FieldID,
+			},
+		}
+	)
+	if id, ok := apc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := apc.mutation.CreatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := apc.mutation.UpdatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := apc.mutation.CreatedAt(); ok {
+		_spec.SetField(accesspolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := apc.mutation.UpdatedAt(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := apc.mutation.DeletedAt(); ok {
+		_spec.SetField(accesspolicy.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := apc.mutation.Name(); ok {
+		_spec.SetField(accesspolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := apc.mutation.Description(); ok {
+		_spec.SetField(accesspolicy.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := apc.mutation.Effect(); ok {
+		_spec.SetField(accesspolicy.FieldEffect, field.TypeEnum, value)
+		_node.Effect = value
+	}
+	if value, ok := apc.mutation.Action(); ok {
+		_spec.SetField(accesspolicy.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := apc.mutation.Resource(); ok {
+		_spec.SetField(accesspolicy.FieldResource, field.TypeString, value)
+		_node.Resource = value
+	}
+	if value, ok := apc.mutation.Condition(); ok {
+		_spec.SetField(accesspolicy.FieldCondition, field.TypeString, value)
+		_node.Condition = value
+	}
+	if value, ok := apc.mutation.Enabled(); ok {
+		_spec.SetField(accesspolicy.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// AccessPolicyCreateBulk is the builder for creating many AccessPolicy entities in bulk.
+type AccessPolicyCreateBulk struct {
+	config
+	builders []*AccessPolicyCreate
+}
+
+// Save creates the AccessPolicy entities in the database.
+func (apcb *AccessPolicyCreateBulk) Save(ctx context.Context) ([]*AccessPolicy, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(apcb.builders))
+	nodes := make([]*AccessPolicy, len(apcb.builders))
+	mutators := make([]Mutator, len(apcb.builders))
+	for i := range apcb.builders {
+		func(i int, root context.Context) {
+			builder := apcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, apcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, apcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, apcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apcb *AccessPolicyCreateBulk) SaveX(ctx context.Context) []*AccessPolicy {
+	v, err := apcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apcb *AccessPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := apcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apcb *AccessPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := apcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
+	"github.com/stark-sim/cas/pkg/ent/predicate"
+)
+
+// AccessPolicyQuery is the builder for querying AccessPolicy entities.
+type AccessPolicyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []OrderFunc
+	inters     []Interceptor
+	predicates []predicate.AccessPolicy
+	loadTotal  []func(context.Context, []*AccessPolicy) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AccessPolicyQuery builder.
+func (apq *AccessPolicyQuery) Where(ps ...predicate.AccessPolicy) *AccessPolicyQuery {
+	apq.predicates = append(apq.predicates, ps...)
+	return apq
+}
+
+// Limit the number of records to be returned by this query.
+func (apq *AccessPolicyQuery) Limit(limit int) *AccessPolicyQuery {
+	apq.ctx.Limit = &limit
+	return apq
+}
+
+// Offset to start from.
+func (apq *AccessPolicyQuery) Offset(offset int) *AccessPolicyQuery {
+	apq.ctx.Offset = &offset
+	return apq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (apq *AccessPolicyQuery) Unique(unique bool) *AccessPolicyQuery {
+	apq.ctx.Unique = &unique
+	return apq
+}
+
+// Order specifies how the records should be ordered.
+func (apq *AccessPolicyQuery) Order(o ...OrderFunc) *AccessPolicyQuery {
+	apq.order = append(apq.order, o...)
+	return apq
+}
+
+// First returns the first AccessPolicy entity from the query.
+// Returns a *NotFoundError when no AccessPolicy was found.
+func (apq *AccessPolicyQuery) First(ctx context.Context) (*AccessPolicy, error) {
+	nodes, err := apq.Limit(1).All(setContextOp(ctx, apq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{accesspolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (apq *AccessPolicyQuery) FirstX(ctx context.Context) *AccessPolicy {
+	node, err := apq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AccessPolicy ID from the query.
+// Returns a *NotFoundError when no AccessPolicy ID was found.
+func (apq *AccessPolicyQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = apq.Limit(1).IDs(setContextOp(ctx, apq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{accesspolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (apq *AccessPolicyQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := apq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AccessPolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AccessPolicy entity is found.
+// Returns a *NotFoundError when no AccessPolicy entities are found.
+func (apq *AccessPolicyQuery) Only(ctx context.Context) (*AccessPolicy, error) {
+	nodes, err := apq.Limit(2).All(setContextOp(ctx, apq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{accesspolicy.Label}
+	default:
+		return nil, &NotSingularError{accesspolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (apq *AccessPolicyQuery) OnlyX(ctx context.Context) *AccessPolicy {
+	node, err := apq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AccessPolicy ID in the query.
+// Returns a *NotSingularError when more than one AccessPolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (apq *AccessPolicyQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = apq.Limit(2).IDs(setContextOp(ctx, apq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{accesspolicy.Label}
+	default:
+		err = &NotSingularError{accesspolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (apq *AccessPolicyQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := apq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AccessPolicies.
+func (apq *AccessPolicyQuery) All(ctx context.Context) ([]*AccessPolicy, error) {
+	ctx = setContextOp(ctx, apq.ctx, "All")
+	if err := apq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AccessPolicy, *AccessPolicyQuery]()
+	return withInterceptors[[]*AccessPolicy](ctx, apq, qr, apq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (apq *AccessPolicyQuery) AllX(ctx context.Context) []*AccessPolicy {
+	nodes, err := apq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AccessPolicy IDs.
+func (apq *AccessPolicyQuery) IDs(ctx context.Context) ([]int64, error) {
+	var ids []int64
+	ctx = setContextOp(ctx, apq.ctx, "IDs")
+	if err := apq.Select(accesspolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (apq *AccessPolicyQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := apq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (apq *AccessPolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, apq.ctx, "Count")
+	if err := apq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, apq, querierCount[*AccessPolicyQuery](), apq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (apq *AccessPolicyQuery) CountX(ctx context.Context) int {
+	count, err := apq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (apq *AccessPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, apq.ctx, "Exist")
+	switch _, err := apq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (apq *AccessPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := apq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AccessPolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (apq *AccessPolicyQuery) Clone() *AccessPolicyQuery {
+	if apq == nil {
+		return nil
+	}
+	return &AccessPolicyQuery{
+		config:     apq.config,
+		ctx:        apq.ctx.Clone(),
+		order:      append([]OrderFunc{}, apq.order...),
+		inters:     append([]Interceptor{}, apq.inters...),
+		predicates: append([]predicate.AccessPolicy{}, apq.predicates...),
+		// clone intermediate query.
+		sql:  apq.sql.Clone(),
+		path: apq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedBy int64 `json:"created_by"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AccessPolicy.Query().
+//		GroupBy(accesspolicy.FieldCreatedBy).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (apq *AccessPolicyQuery) GroupBy(field string, fields ...string) *AccessPolicyGroupBy {
+	apq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AccessPolicyGroupBy{build: apq}
+	grbuild.flds = &apq.ctx.Fields
+	grbuild.label = accesspolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedBy int64 `json:"created_by"`
+//	}
+//
+//	client.AccessPolicy.Query().
+//		Select(accesspolicy.FieldCreatedBy).
+//		Scan(ctx, &v)
+func (apq *AccessPolicyQuery) Select(fields ...string) *AccessPolicySelect {
+	apq.ctx.Fields = append(apq.ctx.Fields, fields...)
+	sbuild := &AccessPolicySelect{AccessPolicyQuery: apq}
+	sbuild.label = accesspolicy.Label
+	sbuild.flds, sbuild.scan = &apq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AccessPolicySelect configured with the given aggregations.
+func (apq *AccessPolicyQuery) Aggregate(fns ...AggregateFunc) *AccessPolicySelect {
+	return apq.Select().Aggregate(fns...)
+}
+
+func (apq *AccessPolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range apq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, apq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range apq.ctx.Fields {
+		if !accesspolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if apq.path != nil {
+		prev, err := apq.path(ctx)
+		if err != nil {
+			return err
+		}
+		apq.sql = prev
+	}
+	return nil
+}
+
+func (apq *AccessPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AccessPolicy, error) {
+	var (
+		nodes = []*AccessPolicy{}
+		_spec = apq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AccessPolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AccessPolicy{config: apq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(apq.modifiers) > 0 {
+		_spec.Modifiers = apq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, apq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range apq.loadTotal {
+		if err := apq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (apq *AccessPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := apq.querySpec()
+	if len(apq.modifiers) > 0 {
+		_spec.Modifiers = apq.modifiers
+	}
+	_spec.Node.Columns = apq.ctx.Fields
+	if len(apq.ctx.Fields) > 0 {
+		_spec.Unique = apq.ctx.Unique != nil && *apq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, apq.driver, _spec)
+}
+
+func (apq *AccessPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   accesspolicy.Table,
+			Columns: accesspolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: accesspolicy.FieldID,
+			},
+		},
+		From:   apq.sql,
+		Unique: true,
+	}
+	if unique := apq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := apq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accesspolicy.FieldID)
+		for i := range fields {
+			if fields[i] != accesspolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := apq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := apq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := apq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := apq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (apq *AccessPolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(apq.driver.Dialect())
+	t1 := builder.Table(accesspolicy.Table)
+	columns := apq.ctx.Fields
+	if len(columns) == 0 {
+		columns = accesspolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if apq.sql != nil {
+		selector = apq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if apq.ctx.Unique != nil && *apq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range apq.modifiers {
+		m(selector)
+	}
+	for _, p := range apq.predicates {
+		p(selector)
+	}
+	for _, p := range apq.order {
+		p(selector)
+	}
+	if offset := apq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := apq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (apq *AccessPolicyQuery) ForUpdate(opts ...sql.LockOption) *AccessPolicyQuery {
+	if apq.driver.Dialect() == dialect.Postgres {
+		apq.Unique(false)
+	}
+	apq.modifiers = append(apq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return apq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (apq *AccessPolicyQuery) ForShare(opts ...sql.LockOption) *AccessPolicyQuery {
+	if apq.driver.Dialect() == dialect.Postgres {
+		apq.Unique(false)
+	}
+	apq.modifiers = append(apq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return apq
+}
+
+// AccessPolicyGroupBy is the group-by builder for AccessPolicy entities.
+type AccessPolicyGroupBy struct {
+	selector
+	build *AccessPolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (apgb *AccessPolicyGroupBy) Aggregate(fns ...AggregateFunc) *AccessPolicyGroupBy {
+	apgb.fns = append(apgb.fns, fns...)
+	return apgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (apgb *AccessPolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, apgb.build.ctx, "GroupBy")
+	if err := apgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AccessPolicyQuery, *AccessPolicyGroupBy](ctx, apgb.build, apgb, apgb.build.inters, v)
+}
+
+func (apgb *AccessPolicyGroupBy) sqlScan(ctx context.Context, root *AccessPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(apgb.fns))
+	for _, fn := range apgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*apgb.flds)+len(apgb.fns))
+		for _, f := range *apgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*apgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := apgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AccessPolicySelect is the builder for selecting fields of AccessPolicy entities.
+type AccessPolicySelect struct {
+	*AccessPolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aps *AccessPolicySelect) Aggregate(fns ...AggregateFunc) *AccessPolicySelect {
+	aps.fns = append(aps.fns, fns...)
+	return aps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aps *AccessPolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aps.ctx, "Select")
+	if err := aps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AccessPolicyQuery, *AccessPolicySelect](ctx, aps.AccessPolicyQuery, aps, aps.inters, v)
+}
+
+func (aps *AccessPolicySelect) sqlScan(ctx context.Context, root *AccessPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aps.fns))
+	for _, fn := range aps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
+	"github.com/stark-sim/cas/pkg/ent/predicate"
+)
+
+// AccessPolicyUpdate is the builder for updating AccessPolicy entities.
+type AccessPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessPolicyMutation
+}
+
+// Where appends a list predicates to the AccessPolicyUpdate builder.
+func (apu *AccessPolicyUpdate) Where(ps ...predicate.AccessPolicy) *AccessPolicyUpdate {
+	apu.mutation.Where(ps...)
+	return apu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (apu *AccessPolicyUpdate) SetCreatedBy(i int64) *AccessPolicyUpdate {
+	apu.mutation.ResetCreatedBy()
+	apu.mutation.SetCreatedBy(i)
+	return apu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableCreatedBy(i *int64) *AccessPolicyUpdate {
+	if i != nil {
+		apu.SetCreatedBy(*i)
+	}
+	return apu
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (apu *AccessPolicyUpdate) AddCreatedBy(i int64) *AccessPolicyUpdate {
+	apu.mutation.AddCreatedBy(i)
+	return apu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (apu *AccessPolicyUpdate) SetUpdatedBy(i int64) *AccessPolicyUpdate {
+	apu.mutation.ResetUpdatedBy()
+	apu.mutation.SetUpdatedBy(i)
+	return apu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableUpdatedBy(i *int64) *AccessPolicyUpdate {
+	if i != nil {
+		apu.SetUpdatedBy(*i)
+	}
+	return apu
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (apu *AccessPolicyUpdate) AddUpdatedBy(i int64) *AccessPolicyUpdate {
+	apu.mutation.AddUpdatedBy(i)
+	return apu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (apu *AccessPolicyUpdate) SetUpdatedAt(t time.Time) *AccessPolicyUpdate {
+	apu.mutation.SetUpdatedAt(t)
+	return apu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (apu *AccessPolicyUpdate) SetDeletedAt(t time.Time) *AccessPolicyUpdate {
+	apu.mutation.SetDeletedAt(t)
+	return apu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableDeletedAt(t *time.Time) *AccessPolicyUpdate {
+	if t != nil {
+		apu.SetDeletedAt(*t)
+	}
+	return apu
+}
+
+// SetName sets the "name" field.
+func (apu *AccessPolicyUpdate) SetName(s string) *AccessPolicyUpdate {
+	apu.mutation.SetName(s)
+	return apu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableName(s *string) *AccessPolicyUpdate {
+	if s != nil {
+		apu.SetName(*s)
+	}
+	return apu
+}
+
+// SetDescription sets the "description" field.
+func (apu *AccessPolicyUpdate) SetDescription(s string) *AccessPolicyUpdate {
+	apu.mutation.SetDescription(s)
+	return apu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableDescription(s *string) *AccessPolicyUpdate {
+	if s != nil {
+		apu.SetDescription(*s)
+	}
+	return apu
+}
+
+// SetEffect sets the "effect" field.
+func (apu *AccessPolicyUpdate) SetEffect(a accesspolicy.Effect) *AccessPolicyUpdate {
+	apu.mutation.SetEffect(a)
+	return apu
+}
+
+// SetNillableEffect sets the "effect" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableEffect(a *accesspolicy.Effect) *AccessPolicyUpdate {
+	if a != nil {
+		apu.SetEffect(*a)
+	}
+	return apu
+}
+
+// SetAction sets the "action" field.
+func (apu *AccessPolicyUpdate) SetAction(s string) *AccessPolicyUpdate {
+	apu.mutation.SetAction(s)
+	return apu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableAction(s *string) *AccessPolicyUpdate {
+	if s != nil {
+		apu.SetAction(*s)
+	}
+	return apu
+}
+
+// SetResource sets the "resource" field.
+func (apu *AccessPolicyUpdate) SetResource(s string) *AccessPolicyUpdate {
+	apu.mutation.SetResource(s)
+	return apu
+}
+
+// SetNillableResource sets the "resource" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableResource(s *string) *AccessPolicyUpdate {
+	if s != nil {
+		apu.SetResource(*s)
+	}
+	return apu
+}
+
+// SetCondition sets the "condition" field.
+func (apu *AccessPolicyUpdate) SetCondition(s string) *AccessPolicyUpdate {
+	apu.mutation.SetCondition(s)
+	return apu
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableCondition(s *string) *AccessPolicyUpdate {
+	if s != nil {
+		apu.SetCondition(*s)
+	}
+	return apu
+}
+
+// SetEnabled sets the "enabled" field.
+func (apu *AccessPolicyUpdate) SetEnabled(b bool) *AccessPolicyUpdate {
+	apu.mutation.SetEnabled(b)
+	return apu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (apu *AccessPolicyUpdate) SetNillableEnabled(b *bool) *AccessPolicyUpdate {
+	if b != nil {
+		apu.SetEnabled(*b)
+	}
+	return apu
+}
+
+// Mutation returns the AccessPolicyMutation object of the builder.
+func (apu *AccessPolicyUpdate) Mutation() *AccessPolicyMutation {
+	return apu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (apu *AccessPolicyUpdate) Save(ctx context.Context) (int, error) {
+	if err := apu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks[int, AccessPolicyMutation](ctx, apu.sqlSave, apu.mutation, apu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apu *AccessPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := apu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (apu *AccessPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := apu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apu *AccessPolicyUpdate) ExecX(ctx context.Context) {
+	if err := apu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apu *AccessPolicyUpdate) defaults() error {
+	if _, ok := apu.mutation.UpdatedAt(); !ok {
+		if accesspolicy.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesspolicy.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesspolicy.UpdateDefaultUpdatedAt()
+		apu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apu *AccessPolicyUpdate) check() error {
+	if v, ok := apu.mutation.Effect(); ok {
+		if err := accesspolicy.EffectValidator(v); err != nil {
+			return &ValidationError{Name: "effect", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.effect": %w`, err)}
+		}
+	}
+	if v, ok := apu.mutation.Condition(); ok {
+		if err := accesspolicy.ConditionValidator(v); err != nil {
+			return &ValidationError{Name: "condition", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.condition": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apu *AccessPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := apu.check(); err != nil {
+		return n, err
+	}
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   accesspolicy.Table,
+			Columns: accesspolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: accesspolicy.FieldID,
+			},
+		},
+	}
+	if ps := apu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apu.mutation.CreatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apu.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apu.mutation.UpdatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apu.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apu.mutation.UpdatedAt(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := apu.mutation.DeletedAt(); ok {
+		_spec.SetField(accesspolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if value, ok := apu.mutation.Name(); ok {
+		_spec.SetField(accesspolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := apu.mutation.Description(); ok {
+		_spec.SetField(accesspolicy.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := apu.mutation.Effect(); ok {
+		_spec.SetField(accesspolicy.FieldEffect, field.TypeEnum, value)
+	}
+	if value, ok := apu.mutation.Action(); ok {
+		_spec.SetField(accesspolicy.FieldAction, field.TypeString, value)
+	}
+	if value, ok := apu.mutation.Resource(); ok {
+		_spec.SetField(accesspolicy.FieldResource, field.TypeString, value)
+	}
+	if value, ok := apu.mutation.Condition(); ok {
+		_spec.SetField(accesspolicy.FieldCondition, field.TypeString, value)
+	}
+	if value, ok := apu.mutation.Enabled(); ok {
+		_spec.SetField(accesspolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, apu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesspolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	apu.mutation.done = true
+	return n, nil
+}
+
+// AccessPolicyUpdateOne is the builder for updating a single AccessPolicy entity.
+type AccessPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessPolicyMutation
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (apuo *AccessPolicyUpdateOne) SetCreatedBy(i int64) *AccessPolicyUpdateOne {
+	apuo.mutation.ResetCreatedBy()
+	apuo.mutation.SetCreatedBy(i)
+	return apuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableCreatedBy(i *int64) *AccessPolicyUpdateOne {
+	if i != nil {
+		apuo.SetCreatedBy(*i)
+	}
+	return apuo
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (apuo *AccessPolicyUpdateOne) AddCreatedBy(i int64) *AccessPolicyUpdateOne {
+	apuo.mutation.AddCreatedBy(i)
+	return apuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (apuo *AccessPolicyUpdateOne) SetUpdatedBy(i int64) *AccessPolicyUpdateOne {
+	apuo.mutation.ResetUpdatedBy()
+	apuo.mutation.SetUpdatedBy(i)
+	return apuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableUpdatedBy(i *int64) *AccessPolicyUpdateOne {
+	if i != nil {
+		apuo.SetUpdatedBy(*i)
+	}
+	return apuo
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (apuo *AccessPolicyUpdateOne) AddUpdatedBy(i int64) *AccessPolicyUpdateOne {
+	apuo.mutation.AddUpdatedBy(i)
+	return apuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (apuo *AccessPolicyUpdateOne) SetUpdatedAt(t time.Time) *AccessPolicyUpdateOne {
+	apuo.mutation.SetUpdatedAt(t)
+	return apuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (apuo *AccessPolicyUpdateOne) SetDeletedAt(t time.Time) *AccessPolicyUpdateOne {
+	apuo.mutation.SetDeletedAt(t)
+	return apuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableDeletedAt(t *time.Time) *AccessPolicyUpdateOne {
+	if t != nil {
+		apuo.SetDeletedAt(*t)
+	}
+	return apuo
+}
+
+// SetName sets the "name" field.
+func (apuo *AccessPolicyUpdateOne) SetName(s string) *AccessPolicyUpdateOne {
+	apuo.mutation.SetName(s)
+	return apuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableName(s *string) *AccessPolicyUpdateOne {
+	if s != nil {
+		apuo.SetName(*s)
+	}
+	return apuo
+}
+
+// SetDescription sets the "description" field.
+func (apuo *AccessPolicyUpdateOne) SetDescription(s string) *AccessPolicyUpdateOne {
+	apuo.mutation.SetDescription(s)
+	return apuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableDescription(s *string) *AccessPolicyUpdateOne {
+	if s != nil {
+		apuo.SetDescription(*s)
+	}
+	return apuo
+}
+
+// SetEffect sets the "effect" field.
+func (apuo *AccessPolicyUpdateOne) SetEffect(a accesspolicy.Effect) *AccessPolicyUpdateOne {
+	apuo.mutation.SetEffect(a)
+	return apuo
+}
+
+// SetNillableEffect sets the "effect" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableEffect(a *accesspolicy.Effect) *AccessPolicyUpdateOne {
+	if a != nil {
+		apuo.SetEffect(*a)
+	}
+	return apuo
+}
+
+// SetAction sets the "action" field.
+func (apuo *AccessPolicyUpdateOne) SetAction(s string) *AccessPolicyUpdateOne {
+	apuo.mutation.SetAction(s)
+	return apuo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableAction(s *string) *AccessPolicyUpdateOne {
+	if s != nil {
+		apuo.SetAction(*s)
+	}
+	return apuo
+}
+
+// SetResource sets the "resource" field.
+func (apuo *AccessPolicyUpdateOne) SetResource(s string) *AccessPolicyUpdateOne {
+	apuo.mutation.SetResource(s)
+	return apuo
+}
+
+// SetNillableResource sets the "resource" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableResource(s *string) *AccessPolicyUpdateOne {
+	if s != nil {
+		apuo.SetResource(*s)
+	}
+	return apuo
+}
+
+// SetCondition sets the "condition" field.
+func (apuo *AccessPolicyUpdateOne) SetCondition(s string) *AccessPolicyUpdateOne {
+	apuo.mutation.SetCondition(s)
+	return apuo
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableCondition(s *string) *AccessPolicyUpdateOne {
+	if s != nil {
+		apuo.SetCondition(*s)
+	}
+	return apuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (apuo *AccessPolicyUpdateOne) SetEnabled(b bool) *AccessPolicyUpdateOne {
+	apuo.mutation.SetEnabled(b)
+	return apuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (apuo *AccessPolicyUpdateOne) SetNillableEnabled(b *bool) *AccessPolicyUpdateOne {
+	if b != nil {
+		apuo.SetEnabled(*b)
+	}
+	return apuo
+}
+
+// Mutation returns the AccessPolicyMutation object of the builder.
+func (apuo *AccessPolicyUpdateOne) Mutation() *AccessPolicyMutation {
+	return apuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (apuo *AccessPolicyUpdateOne) Select(field string, fields ...string) *AccessPolicyUpdateOne {
+	apuo.fields = append([]string{field}, fields...)
+	return apuo
+}
+
+// Save executes the query and returns the updated AccessPolicy entity.
+func (apuo *AccessPolicyUpdateOne) Save(ctx context.Context) (*AccessPolicy, error) {
+	if err := apuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*AccessPolicy, AccessPolicyMutation](ctx, apuo.sqlSave, apuo.mutation, apuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apuo *AccessPolicyUpdateOne) SaveX(ctx context.Context) *AccessPolicy {
+	node, err := apuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (apuo *AccessPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := apuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apuo *AccessPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := apuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apuo *AccessPolicyUpdateOne) defaults() error {
+	if _, ok := apuo.mutation.UpdatedAt(); !ok {
+		if accesspolicy.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesspolicy.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesspolicy.UpdateDefaultUpdatedAt()
+		apuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apuo *AccessPolicyUpdateOne) check() error {
+	if v, ok := apuo.mutation.Effect(); ok {
+		if err := accesspolicy.EffectValidator(v); err != nil {
+			return &ValidationError{Name: "effect", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.effect": %w`, err)}
+		}
+	}
+	if v, ok := apuo.mutation.Condition(); ok {
+		if err := accesspolicy.ConditionValidator(v); err != nil {
+			return &ValidationError{Name: "condition", err: fmt.Errorf(`ent: validator failed for field "AccessPolicy.condition": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apuo *AccessPolicyUpdateOne) sqlSave(ctx context.Context) (_node *AccessPolicy, err error) {
+	if err := apuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   accesspolicy.Table,
+			Columns: accesspolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: accesspolicy.FieldID,
+			},
+		},
+	}
+	id, ok := apuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := apuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accesspolicy.FieldID)
+		for _, f := range fields {
+			if !accesspolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accesspolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := apuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apuo.mutation.CreatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apuo.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(accesspolicy.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apuo.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(accesspolicy.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := apuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(accesspolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := apuo.mutation.DeletedAt(); ok {
+		_spec.SetField(accesspolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if value, ok := apuo.mutation.Name(); ok {
+		_spec.SetField(accesspolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := apuo.mutation.Description(); ok {
+		_spec.SetField(accesspolicy.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := apuo.mutation.Effect(); ok {
+		_spec.SetField(accesspolicy.FieldEffect, field.TypeEnum, value)
+	}
+	if value, ok := apuo.mutation.Action(); ok {
+		_spec.SetField(accesspolicy.FieldAction, field.TypeString, value)
+	}
+	if value, ok := apuo.mutation.Resource(); ok {
+		_spec.SetField(accesspolicy.FieldResource, field.TypeString, value)
+	}
+	if value, ok := apuo.mutation.Condition(); ok {
+		_spec.SetField(accesspolicy.FieldCondition, field.TypeString, value)
+	}
+	if value, ok := apuo.mutation.Enabled(); ok {
+		_spec.SetField(accesspolicy.FieldEnabled, field.TypeBool, value)
+	}
+	_node = &AccessPolicy{config: apuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, apuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesspolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	apuo.mutation.done = true
+	return _node, nil
+}
@@ -20,14 +20,35 @@ type config struct {
 	log func(...any)
 	// hooks to execute on mutations.
 	hooks *hooks
+	// interceptors to execute on queries.
+	inters *inters
 }
 
-// hooks per client, for fast access.
-type hooks struct {
-	Role     []ent.Hook
-	User     []ent.Hook
-	UserRole []ent.Hook
-}
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		AccessPolicy        []ent.Hook
+		AuditEvent          []ent.Hook
+		OutboxEvent         []ent.Hook
+		RelationTuple       []ent.Hook
+		Role                []ent.Hook
+		User                []ent.Hook
+		UserRole            []ent.Hook
+		WebhookDelivery     []ent.Hook
+		WebhookSubscription []ent.Hook
+	}
+	inters struct {
+		AccessPolicy        []ent.Interceptor
+		AuditEvent          []ent.Interceptor
+		OutboxEvent         []ent.Interceptor
+		RelationTuple       []ent.Interceptor
+		Role                []ent.Interceptor
+		User                []ent.Interceptor
+		UserRole            []ent.Interceptor
+		WebhookDelivery     []ent.Interceptor
+		WebhookSubscription []ent.Interceptor
+	}
+)
 
 // Options applies the options on the config object.
 func (c *config) options(opts ...Option) {
@@ -23,7 +23,7 @@ func main() {
 	}
 	// 整合 versioned migrations 和 GraphQL schema
 	if err := entc.Generate("./schema", &gen.Config{
-		Features:  []gen.Feature{gen.FeatureVersionedMigration},
+		Features:  []gen.Feature{gen.FeatureVersionedMigration, gen.FeatureIntercept, gen.FeatureLock},
 		Templates: entgql.AllTemplates,
 	}, entc.Extensions(ex), entc.TemplateDir("./template")); err != nil {
 		log.Fatalf("running ent codegen: %v", err)
@@ -8,52 +8,88 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
-func (r *Role) Users(ctx context.Context) (result []*User, err error) {
-	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
-		result, err = r.NamedUsers(graphql.GetFieldContext(ctx).Field.Alias)
-	} else {
-		result, err = r.Edges.UsersOrErr()
+func (r *Role) Users(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *UserOrder, where *UserWhereInput,
+) (*UserConnection, error) {
+	opts := []UserPaginateOption{
+		WithUserOrder(orderBy),
+		WithUserFilter(where.Filter),
 	}
-	if IsNotLoaded(err) {
-		result, err = r.QueryUsers().All(ctx)
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := r.Edges.totalCount[0][alias]
+	if nodes, err := r.NamedUsers(alias); err == nil || hasTotalCount {
+		pager, err := newUserPager(opts)
+		if err != nil {
+			return nil, err
+		}
+		conn := &UserConnection{Edges: []*UserEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
 	}
-	return result, err
+	return r.QueryUsers().Paginate(ctx, after, first, before, last, opts...)
 }
 
-func (r *Role) UserRoles(ctx context.Context) (result []*UserRole, err error) {
-	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
-		result, err = r.NamedUserRoles(graphql.GetFieldContext(ctx).Field.Alias)
-	} else {
-		result, err = r.Edges.UserRolesOrErr()
+func (r *Role) UserRoles(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *UserRoleOrder, where *UserRoleWhereInput,
+) (*UserRoleConnection, error) {
+	opts := []UserRolePaginateOption{
+		WithUserRoleOrder(orderBy),
+		WithUserRoleFilter(where.Filter),
 	}
-	if IsNotLoaded(err) {
-		result, err = r.QueryUserRoles().All(ctx)
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := r.Edges.totalCount[1][alias]
+	if nodes, err := r.NamedUserRoles(alias); err == nil || hasTotalCount {
+		pager, err := newUserRolePager(opts)
+		if err != nil {
+			return nil, err
+		}
+		conn := &UserRoleConnection{Edges: []*UserRoleEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
 	}
-	return result, err
+	return r.QueryUserRoles().Paginate(ctx, after, first, before, last, opts...)
 }
 
-func (u *User) Roles(ctx context.Context) (result []*Role, err error) {
-	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
-		result, err = u.NamedRoles(graphql.GetFieldContext(ctx).Field.Alias)
-	} else {
-		result, err = u.Edges.RolesOrErr()
+func (u *User) Roles(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *RoleOrder, where *RoleWhereInput,
+) (*RoleConnection, error) {
+	opts := []RolePaginateOption{
+		WithRoleOrder(orderBy),
+		WithRoleFilter(where.Filter),
 	}
-	if IsNotLoaded(err) {
-		result, err = u.QueryRoles().All(ctx)
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := u.Edges.totalCount[0][alias]
+	if nodes, err := u.NamedRoles(alias); err == nil || hasTotalCount {
+		pager, err := newRolePager(opts)
+		if err != nil {
+			return nil, err
+		}
+		conn := &RoleConnection{Edges: []*RoleEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
 	}
-	return result, err
+	return u.QueryRoles().Paginate(ctx, after, first, before, last, opts...)
 }
 
-func (u *User) UserRoles(ctx context.Context) (result []*UserRole, err error) {
-	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
-		result, err = u.NamedUserRoles(graphql.GetFieldContext(ctx).Field.Alias)
-	} else {
-		result, err = u.Edges.UserRolesOrErr()
+func (u *User) UserRoles(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *UserRoleOrder, where *UserRoleWhereInput,
+) (*UserRoleConnection, error) {
+	opts := []UserRolePaginateOption{
+		WithUserRoleOrder(orderBy),
+		WithUserRoleFilter(where.Filter),
 	}
-	if IsNotLoaded(err) {
-		result, err = u.QueryUserRoles().All(ctx)
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := u.Edges.totalCount[1][alias]
+	if nodes, err := u.NamedUserRoles(alias); err == nil || hasTotalCount {
+		pager, err := newUserRolePager(opts)
+		if err != nil {
+			return nil, err
+		}
+		conn := &UserRoleConnection{Edges: []*UserRoleEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
 	}
-	return result, err
+	return u.QueryUserRoles().Paginate(ctx, after, first, before, last, opts...)
 }
 
 func (ur *UserRole) User(ctx context.Context) (*User, error) {
@@ -71,3 +107,11 @@ func (ur *UserRole) Role(ctx context.Context) (*Role, error) {
 	}
 	return result, err
 }
+
+func (wd *WebhookDelivery) Subscription(ctx context.Context) (*WebhookSubscription, error) {
+	result, err := wd.Edges.SubscriptionOrErr()
+	if IsNotLoaded(err) {
+		result, err = wd.QuerySubscription().Only(ctx)
+	}
+	return result, err
+}
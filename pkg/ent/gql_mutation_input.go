@@ -4,12 +4,122 @@ package ent
 
 import (
 	"time"
+
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
 )
 
+// CreateAccessPolicyInput represents a mutation input for creating accesspolicies.
+type CreateAccessPolicyInput struct {
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	DeletedAt   *time.Time
+	Name        *string
+	Description *string
+	Effect      *accesspolicy.Effect
+	Action      *string
+	Resource    *string
+	Condition   *string
+	Enabled     *bool
+}
+
+// Mutate applies the CreateAccessPolicyInput on the AccessPolicyMutation builder.
+func (i *CreateAccessPolicyInput) Mutate(m *AccessPolicyMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.DeletedAt; v != nil {
+		m.SetDeletedAt(*v)
+	}
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Effect; v != nil {
+		m.SetEffect(*v)
+	}
+	if v := i.Action; v != nil {
+		m.SetAction(*v)
+	}
+	if v := i.Resource; v != nil {
+		m.SetResource(*v)
+	}
+	if v := i.Condition; v != nil {
+		m.SetCondition(*v)
+	}
+	if v := i.Enabled; v != nil {
+		m.SetEnabled(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateAccessPolicyInput on the AccessPolicyCreate builder.
+func (c *AccessPolicyCreate) SetInput(i CreateAccessPolicyInput) *AccessPolicyCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateAccessPolicyInput represents a mutation input for updating accesspolicies.
+type UpdateAccessPolicyInput struct {
+	UpdatedAt   *time.Time
+	DeletedAt   *time.Time
+	Name        *string
+	Description *string
+	Effect      *accesspolicy.Effect
+	Action      *string
+	Resource    *string
+	Condition   *string
+	Enabled     *bool
+}
+
+// Mutate applies the UpdateAccessPolicyInput on the AccessPolicyMutation builder.
+func (i *UpdateAccessPolicyInput) Mutate(m *AccessPolicyMutation) {
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.DeletedAt; v != nil {
+		m.SetDeletedAt(*v)
+	}
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Effect; v != nil {
+		m.SetEffect(*v)
+	}
+	if v := i.Action; v != nil {
+		m.SetAction(*v)
+	}
+	if v := i.Resource; v != nil {
+		m.SetResource(*v)
+	}
+	if v := i.Condition; v != nil {
+		m.SetCondition(*v)
+	}
+	if v := i.Enabled; v != nil {
+		m.SetEnabled(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateAccessPolicyInput on the AccessPolicyUpdate builder.
+func (c *AccessPolicyUpdate) SetInput(i UpdateAccessPolicyInput) *AccessPolicyUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateAccessPolicyInput on the AccessPolicyUpdateOne builder.
+func (c *AccessPolicyUpdateOne) SetInput(i UpdateAccessPolicyInput) *AccessPolicyUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateRoleInput represents a mutation input for creating roles.
 type CreateRoleInput struct {
-	CreatedBy *int64
-	UpdatedBy *int64
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
 	DeletedAt *time.Time
@@ -19,12 +129,6 @@ type CreateRoleInput struct {
 
 // Mutate applies the CreateRoleInput on the RoleMutation builder.
 func (i *CreateRoleInput) Mutate(m *RoleMutation) {
-	if v := i.CreatedBy; v != nil {
-		m.SetCreatedBy(*v)
-	}
-	if v := i.UpdatedBy; v != nil {
-		m.SetUpdatedBy(*v)
-	}
 	if v := i.CreatedAt; v != nil {
 		m.SetCreatedAt(*v)
 	}
@@ -50,23 +154,16 @@ func (c *RoleCreate) SetInput(i CreateRoleInput) *RoleCreate {
 
 // UpdateRoleInput represents a mutation input for updating roles.
 type UpdateRoleInput struct {
-	CreatedBy     *int64
-	UpdatedBy     *int64
 	UpdatedAt     *time.Time
 	DeletedAt     *time.Time
 	Name          *string
+	ClearUsers    bool
 	AddUserIDs    []int64
 	RemoveUserIDs []int64
 }
 
 // Mutate applies the UpdateRoleInput on the RoleMutation builder.
 func (i *UpdateRoleInput) Mutate(m *RoleMutation) {
-	if v := i.CreatedBy; v != nil {
-		m.SetCreatedBy(*v)
-	}
-	if v := i.UpdatedBy; v != nil {
-		m.SetUpdatedBy(*v)
-	}
 	if v := i.UpdatedAt; v != nil {
 		m.SetUpdatedAt(*v)
 	}
@@ -76,6 +173,9 @@ func (i *UpdateRoleInput) Mutate(m *RoleMutation) {
 	if v := i.Name; v != nil {
 		m.SetName(*v)
 	}
+	if i.ClearUsers {
+		m.ClearUsers()
+	}
 	if v := i.AddUserIDs; len(v) > 0 {
 		m.AddUserIDs(v...)
 	}
@@ -98,8 +198,6 @@ func (c *RoleUpdateOne) SetInput(i UpdateRoleInput) *RoleUpdateOne {
 
 // CreateUserInput represents a mutation input for creating users.
 type CreateUserInput struct {
-	CreatedBy *int64
-	UpdatedBy *int64
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
 	DeletedAt *time.Time
@@ -110,12 +208,6 @@ type CreateUserInput struct {
 
 // Mutate applies the CreateUserInput on the UserMutation builder.
 func (i *CreateUserInput) Mutate(m *UserMutation) {
-	if v := i.CreatedBy; v != nil {
-		m.SetCreatedBy(*v)
-	}
-	if v := i.UpdatedBy; v != nil {
-		m.SetUpdatedBy(*v)
-	}
 	if v := i.CreatedAt; v != nil {
 		m.SetCreatedAt(*v)
 	}
@@ -142,24 +234,17 @@ func (c *UserCreate) SetInput(i CreateUserInput) *UserCreate {
 
 // UpdateUserInput represents a mutation input for updating users.
 type UpdateUserInput struct {
-	CreatedBy     *int64
-	UpdatedBy     *int64
 	UpdatedAt     *time.Time
 	DeletedAt     *time.Time
 	Name          *string
 	Phone         *string
+	ClearRoles    bool
 	AddRoleIDs    []int64
 	RemoveRoleIDs []int64
 }
 
 // Mutate applies the UpdateUserInput on the UserMutation builder.
 func (i *UpdateUserInput) Mutate(m *UserMutation) {
-	if v := i.CreatedBy; v != nil {
-		m.SetCreatedBy(*v)
-	}
-	if v := i.UpdatedBy; v != nil {
-		m.SetUpdatedBy(*v)
-	}
 	if v := i.UpdatedAt; v != nil {
 		m.SetUpdatedAt(*v)
 	}
@@ -172,6 +257,9 @@ func (i *UpdateUserInput) Mutate(m *UserMutation) {
 	if v := i.Phone; v != nil {
 		m.SetPhone(*v)
 	}
+	if i.ClearRoles {
+		m.ClearRoles()
+	}
 	if v := i.AddRoleIDs; len(v) > 0 {
 		m.AddRoleIDs(v...)
 	}
@@ -191,3 +279,101 @@ func (c *UserUpdateOne) SetInput(i UpdateUserInput) *UserUpdateOne {
 	i.Mutate(c.Mutation())
 	return c
 }
+
+// CreateWebhookSubscriptionInput represents a mutation input for creating webhooksubscriptions.
+type CreateWebhookSubscriptionInput struct {
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+	DeletedAt  *time.Time
+	Name       *string
+	URL        string
+	EventTypes []string
+	Secret     string
+	Enabled    *bool
+}
+
+// Mutate applies the CreateWebhookSubscriptionInput on the WebhookSubscriptionMutation builder.
+func (i *CreateWebhookSubscriptionInput) Mutate(m *WebhookSubscriptionMutation) {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.DeletedAt; v != nil {
+		m.SetDeletedAt(*v)
+	}
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	m.SetURL(i.URL)
+	if v := i.EventTypes; v != nil {
+		m.SetEventTypes(v)
+	}
+	m.SetSecret(i.Secret)
+	if v := i.Enabled; v != nil {
+		m.SetEnabled(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateWebhookSubscriptionInput on the WebhookSubscriptionCreate builder.
+func (c *WebhookSubscriptionCreate) SetInput(i CreateWebhookSubscriptionInput) *WebhookSubscriptionCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateWebhookSubscriptionInput represents a mutation input for updating webhooksubscriptions.
+type UpdateWebhookSubscriptionInput struct {
+	UpdatedAt        *time.Time
+	DeletedAt        *time.Time
+	Name             *string
+	URL              *string
+	ClearEventTypes  bool
+	EventTypes       []string
+	AppendEventTypes []string
+	Secret           *string
+	Enabled          *bool
+}
+
+// Mutate applies the UpdateWebhookSubscriptionInput on the WebhookSubscriptionMutation builder.
+func (i *UpdateWebhookSubscriptionInput) Mutate(m *WebhookSubscriptionMutation) {
+	if v := i.UpdatedAt; v != nil {
+		m.SetUpdatedAt(*v)
+	}
+	if v := i.DeletedAt; v != nil {
+		m.SetDeletedAt(*v)
+	}
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.URL; v != nil {
+		m.SetURL(*v)
+	}
+	if i.ClearEventTypes {
+		m.ClearEventTypes()
+	}
+	if v := i.EventTypes; v != nil {
+		m.SetEventTypes(v)
+	}
+	if i.AppendEventTypes != nil {
+		m.AppendEventTypes(i.EventTypes)
+	}
+	if v := i.Secret; v != nil {
+		m.SetSecret(*v)
+	}
+	if v := i.Enabled; v != nil {
+		m.SetEnabled(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateWebhookSubscriptionInput on the WebhookSubscriptionUpdate builder.
+func (c *WebhookSubscriptionUpdate) SetInput(i UpdateWebhookSubscriptionInput) *WebhookSubscriptionUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateWebhookSubscriptionInput on the WebhookSubscriptionUpdateOne builder.
+func (c *WebhookSubscriptionUpdateOne) SetInput(i UpdateWebhookSubscriptionInput) *WebhookSubscriptionUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
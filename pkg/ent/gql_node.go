@@ -4,7 +4,6 @@ package ent
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -15,299 +14,41 @@ import (
 	"entgo.io/ent/dialect/sql/schema"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/hashicorp/go-multierror"
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
+	"github.com/stark-sim/cas/pkg/ent/auditevent"
 	"github.com/stark-sim/cas/pkg/ent/role"
 	"github.com/stark-sim/cas/pkg/ent/user"
 	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/pkg/ent/webhookdelivery"
+	"github.com/stark-sim/cas/pkg/ent/webhooksubscription"
 	"golang.org/x/sync/semaphore"
 )
 
 // Noder wraps the basic Node method.
 type Noder interface {
-	Node(context.Context) (*Node, error)
+	IsNode()
 }
 
-// Node in the graph.
-type Node struct {
-	ID     int64    `json:"id,omitempty"`     // node id.
-	Type   string   `json:"type,omitempty"`   // node type.
-	Fields []*Field `json:"fields,omitempty"` // node fields.
-	Edges  []*Edge  `json:"edges,omitempty"`  // node edges.
-}
+// IsNode implements the Node interface check for GQLGen.
+func (n *AccessPolicy) IsNode() {}
 
-// Field of a node.
-type Field struct {
-	Type  string `json:"type,omitempty"`  // field type.
-	Name  string `json:"name,omitempty"`  // field name (as in struct).
-	Value string `json:"value,omitempty"` // stringified value.
-}
+// IsNode implements the Node interface check for GQLGen.
+func (n *AuditEvent) IsNode() {}
 
-// Edges between two nodes.
-type Edge struct {
-	Type string  `json:"type,omitempty"` // edge type.
-	Name string  `json:"name,omitempty"` // edge name.
-	IDs  []int64 `json:"ids,omitempty"`  // node ids (where this edge point to).
-}
+// IsNode implements the Node interface check for GQLGen.
+func (n *Role) IsNode() {}
 
-func (r *Role) Node(ctx context.Context) (node *Node, err error) {
-	node = &Node{
-		ID:     r.ID,
-		Type:   "Role",
-		Fields: make([]*Field, 6),
-		Edges:  make([]*Edge, 2),
-	}
-	var buf []byte
-	if buf, err = json.Marshal(r.CreatedBy); err != nil {
-		return nil, err
-	}
-	node.Fields[0] = &Field{
-		Type:  "int64",
-		Name:  "created_by",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(r.UpdatedBy); err != nil {
-		return nil, err
-	}
-	node.Fields[1] = &Field{
-		Type:  "int64",
-		Name:  "updated_by",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(r.CreatedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[2] = &Field{
-		Type:  "time.Time",
-		Name:  "created_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(r.UpdatedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[3] = &Field{
-		Type:  "time.Time",
-		Name:  "updated_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(r.DeletedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[4] = &Field{
-		Type:  "time.Time",
-		Name:  "deleted_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(r.Name); err != nil {
-		return nil, err
-	}
-	node.Fields[5] = &Field{
-		Type:  "string",
-		Name:  "name",
-		Value: string(buf),
-	}
-	node.Edges[0] = &Edge{
-		Type: "User",
-		Name: "users",
-	}
-	err = r.QueryUsers().
-		Select(user.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[1] = &Edge{
-		Type: "UserRole",
-		Name: "user_roles",
-	}
-	err = r.QueryUserRoles().
-		Select(userrole.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
-		return nil, err
-	}
-	return node, nil
-}
+// IsNode implements the Node interface check for GQLGen.
+func (n *User) IsNode() {}
 
-func (u *User) Node(ctx context.Context) (node *Node, err error) {
-	node = &Node{
-		ID:     u.ID,
-		Type:   "User",
-		Fields: make([]*Field, 7),
-		Edges:  make([]*Edge, 2),
-	}
-	var buf []byte
-	if buf, err = json.Marshal(u.CreatedBy); err != nil {
-		return nil, err
-	}
-	node.Fields[0] = &Field{
-		Type:  "int64",
-		Name:  "created_by",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(u.UpdatedBy); err != nil {
-		return nil, err
-	}
-	node.Fields[1] = &Field{
-		Type:  "int64",
-		Name:  "updated_by",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(u.CreatedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[2] = &Field{
-		Type:  "time.Time",
-		Name:  "created_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(u.UpdatedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[3] = &Field{
-		Type:  "time.Time",
-		Name:  "updated_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(u.DeletedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[4] = &Field{
-		Type:  "time.Time",
-		Name:  "deleted_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(u.Name); err != nil {
-		return nil, err
-	}
-	node.Fields[5] = &Field{
-		Type:  "string",
-		Name:  "name",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(u.Phone); err != nil {
-		return nil, err
-	}
-	node.Fields[6] = &Field{
-		Type:  "string",
-		Name:  "phone",
-		Value: string(buf),
-	}
-	node.Edges[0] = &Edge{
-		Type: "Role",
-		Name: "roles",
-	}
-	err = u.QueryRoles().
-		Select(role.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[1] = &Edge{
-		Type: "UserRole",
-		Name: "user_roles",
-	}
-	err = u.QueryUserRoles().
-		Select(userrole.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
-		return nil, err
-	}
-	return node, nil
-}
+// IsNode implements the Node interface check for GQLGen.
+func (n *UserRole) IsNode() {}
 
-func (ur *UserRole) Node(ctx context.Context) (node *Node, err error) {
-	node = &Node{
-		ID:     ur.ID,
-		Type:   "UserRole",
-		Fields: make([]*Field, 7),
-		Edges:  make([]*Edge, 2),
-	}
-	var buf []byte
-	if buf, err = json.Marshal(ur.CreatedBy); err != nil {
-		return nil, err
-	}
-	node.Fields[0] = &Field{
-		Type:  "int64",
-		Name:  "created_by",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(ur.UpdatedBy); err != nil {
-		return nil, err
-	}
-	node.Fields[1] = &Field{
-		Type:  "int64",
-		Name:  "updated_by",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(ur.CreatedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[2] = &Field{
-		Type:  "time.Time",
-		Name:  "created_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(ur.UpdatedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[3] = &Field{
-		Type:  "time.Time",
-		Name:  "updated_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(ur.DeletedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[4] = &Field{
-		Type:  "time.Time",
-		Name:  "deleted_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(ur.UserID); err != nil {
-		return nil, err
-	}
-	node.Fields[5] = &Field{
-		Type:  "int64",
-		Name:  "user_id",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(ur.RoleID); err != nil {
-		return nil, err
-	}
-	node.Fields[6] = &Field{
-		Type:  "int64",
-		Name:  "role_id",
-		Value: string(buf),
-	}
-	node.Edges[0] = &Edge{
-		Type: "User",
-		Name: "user",
-	}
-	err = ur.QueryUser().
-		Select(user.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[1] = &Edge{
-		Type: "Role",
-		Name: "role",
-	}
-	err = ur.QueryRole().
-		Select(role.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
-		return nil, err
-	}
-	return node, nil
-}
+// IsNode implements the Node interface check for GQLGen.
+func (n *WebhookDelivery) IsNode() {}
 
-func (c *Client) Node(ctx context.Context, id int64) (*Node, error) {
-	n, err := c.Noder(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-	return n.Node(ctx)
-}
+// IsNode implements the Node interface check for GQLGen.
+func (n *WebhookSubscription) IsNode() {}
 
 var errNodeInvalidID = &NotFoundError{"node"}
 
@@ -367,6 +108,30 @@ func (c *Client) Noder(ctx context.Context, id int64, opts ...NodeOption) (_ Nod
 
 func (c *Client) noder(ctx context.Context, table string, id int64) (Noder, error) {
 	switch table {
+	case accesspolicy.Table:
+		query := c.AccessPolicy.Query().
+			Where(accesspolicy.ID(id))
+		query, err := query.CollectFields(ctx, "AccessPolicy")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case auditevent.Table:
+		query := c.AuditEvent.Query().
+			Where(auditevent.ID(id))
+		query, err := query.CollectFields(ctx, "AuditEvent")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case role.Table:
 		query := c.Role.Query().
 			Where(role.ID(id))
@@ -403,6 +168,30 @@ func (c *Client) noder(ctx context.Context, table string, id int64) (Noder, erro
 			return nil, err
 		}
 		return n, nil
+	case webhookdelivery.Table:
+		query := c.WebhookDelivery.Query().
+			Where(webhookdelivery.ID(id))
+		query, err := query.CollectFields(ctx, "WebhookDelivery")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	case webhooksubscription.Table:
+		query := c.WebhookSubscription.Query().
+			Where(webhooksubscription.ID(id))
+		query, err := query.CollectFields(ctx, "WebhookSubscription")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	default:
 		return nil, fmt.Errorf("cannot resolve noder from table %q: %w", table, errNodeInvalidID)
 	}
@@ -476,6 +265,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []int64) ([]Noder
 		idmap[id] = append(idmap[id], &noders[i])
 	}
 	switch table {
+	case accesspolicy.Table:
+		query := c.AccessPolicy.Query().
+			Where(accesspolicy.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "AccessPolicy")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case auditevent.Table:
+		query := c.AuditEvent.Query().
+			Where(auditevent.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "AuditEvent")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case role.Table:
 		query := c.Role.Query().
 			Where(role.IDIn(ids...))
@@ -524,6 +345,38 @@ func (c *Client) noders(ctx context.Context, table string, ids []int64) ([]Noder
 				*noder = node
 			}
 		}
+	case webhookdelivery.Table:
+		query := c.WebhookDelivery.Query().
+			Where(webhookdelivery.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "WebhookDelivery")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case webhooksubscription.Table:
+		query := c.WebhookSubscription.Query().
+			Where(webhooksubscription.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "WebhookSubscription")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	default:
 		return nil, fmt.Errorf("cannot resolve noders from table %q: %w", table, errNodeInvalidID)
 	}
@@ -14,9 +14,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
+	"github.com/stark-sim/cas/pkg/ent/auditevent"
 	"github.com/stark-sim/cas/pkg/ent/role"
 	"github.com/stark-sim/cas/pkg/ent/user"
 	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/pkg/ent/webhookdelivery"
+	"github.com/stark-sim/cas/pkg/ent/webhooksubscription"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"github.com/vmihailenco/msgpack/v5"
 )
@@ -243,20 +247,20 @@ func paginateLimit(first, last *int) int {
 	return limit
 }
 
-// RoleEdge is the edge representation of Role.
-type RoleEdge struct {
-	Node   *Role  `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// AccessPolicyEdge is the edge representation of AccessPolicy.
+type AccessPolicyEdge struct {
+	Node   *AccessPolicy `json:"node"`
+	Cursor Cursor        `json:"cursor"`
 }
 
-// RoleConnection is the connection containing edges to Role.
-type RoleConnection struct {
-	Edges      []*RoleEdge `json:"edges"`
-	PageInfo   PageInfo    `json:"pageInfo"`
-	TotalCount int         `json:"totalCount"`
+// AccessPolicyConnection is the connection containing edges to AccessPolicy.
+type AccessPolicyConnection struct {
+	Edges      []*AccessPolicyEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
 }
 
-func (c *RoleConnection) build(nodes []*Role, pager *rolePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *AccessPolicyConnection) build(nodes []*AccessPolicy, pager *accesspolicyPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -266,21 +270,21 @@ func (c *RoleConnection) build(nodes []*Role, pager *rolePager, after *Cursor, f
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Role
+	var nodeAt func(int) *AccessPolicy
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Role {
+		nodeAt = func(i int) *AccessPolicy {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Role {
+		nodeAt = func(i int) *AccessPolicy {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*RoleEdge, len(nodes))
+	c.Edges = make([]*AccessPolicyEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &RoleEdge{
+		c.Edges[i] = &AccessPolicyEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -294,123 +298,123 @@ func (c *RoleConnection) build(nodes []*Role, pager *rolePager, after *Cursor, f
 	}
 }
 
-// RolePaginateOption enables pagination customization.
-type RolePaginateOption func(*rolePager) error
+// AccessPolicyPaginateOption enables pagination customization.
+type AccessPolicyPaginateOption func(*accesspolicyPager) error
 
-// WithRoleOrder configures pagination ordering.
-func WithRoleOrder(order *RoleOrder) RolePaginateOption {
+// WithAccessPolicyOrder configures pagination ordering.
+func WithAccessPolicyOrder(order *AccessPolicyOrder) AccessPolicyPaginateOption {
 	if order == nil {
-		order = DefaultRoleOrder
+		order = DefaultAccessPolicyOrder
 	}
 	o := *order
-	return func(pager *rolePager) error {
+	return func(pager *accesspolicyPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultRoleOrder.Field
+			o.Field = DefaultAccessPolicyOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithRoleFilter configures pagination filter.
-func WithRoleFilter(filter func(*RoleQuery) (*RoleQuery, error)) RolePaginateOption {
-	return func(pager *rolePager) error {
+// WithAccessPolicyFilter configures pagination filter.
+func WithAccessPolicyFilter(filter func(*AccessPolicyQuery) (*AccessPolicyQuery, error)) AccessPolicyPaginateOption {
+	return func(pager *accesspolicyPager) error {
 		if filter == nil {
-			return errors.New("RoleQuery filter cannot be nil")
+			return errors.New("AccessPolicyQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type rolePager struct {
-	order  *RoleOrder
-	filter func(*RoleQuery) (*RoleQuery, error)
+type accesspolicyPager struct {
+	order  *AccessPolicyOrder
+	filter func(*AccessPolicyQuery) (*AccessPolicyQuery, error)
 }
 
-func newRolePager(opts []RolePaginateOption) (*rolePager, error) {
-	pager := &rolePager{}
+func newAccessPolicyPager(opts []AccessPolicyPaginateOption) (*accesspolicyPager, error) {
+	pager := &accesspolicyPager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultRoleOrder
+		pager.order = DefaultAccessPolicyOrder
 	}
 	return pager, nil
 }
 
-func (p *rolePager) applyFilter(query *RoleQuery) (*RoleQuery, error) {
+func (p *accesspolicyPager) applyFilter(query *AccessPolicyQuery) (*AccessPolicyQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *rolePager) toCursor(r *Role) Cursor {
-	return p.order.Field.toCursor(r)
+func (p *accesspolicyPager) toCursor(ap *AccessPolicy) Cursor {
+	return p.order.Field.toCursor(ap)
 }
 
-func (p *rolePager) applyCursors(query *RoleQuery, after, before *Cursor) *RoleQuery {
+func (p *accesspolicyPager) applyCursors(query *AccessPolicyQuery, after, before *Cursor) *AccessPolicyQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultRoleOrder.Field.field,
+		p.order.Field.field, DefaultAccessPolicyOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *rolePager) applyOrder(query *RoleQuery, reverse bool) *RoleQuery {
+func (p *accesspolicyPager) applyOrder(query *AccessPolicyQuery, reverse bool) *AccessPolicyQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultRoleOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultRoleOrder.Field.field))
+	if p.order.Field != DefaultAccessPolicyOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultAccessPolicyOrder.Field.field))
 	}
 	return query
 }
 
-func (p *rolePager) orderExpr(reverse bool) sql.Querier {
+func (p *accesspolicyPager) orderExpr(reverse bool) sql.Querier {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultRoleOrder.Field {
-			b.Comma().Ident(DefaultRoleOrder.Field.field).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAccessPolicyOrder.Field {
+			b.Comma().Ident(DefaultAccessPolicyOrder.Field.field).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Role.
-func (r *RoleQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to AccessPolicy.
+func (ap *AccessPolicyQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...RolePaginateOption,
-) (*RoleConnection, error) {
+	before *Cursor, last *int, opts ...AccessPolicyPaginateOption,
+) (*AccessPolicyConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newRolePager(opts)
+	pager, err := newAccessPolicyPager(opts)
 	if err != nil {
 		return nil, err
 	}
-	if r, err = pager.applyFilter(r); err != nil {
+	if ap, err = pager.applyFilter(ap); err != nil {
 		return nil, err
 	}
-	conn := &RoleConnection{Edges: []*RoleEdge{}}
+	conn := &AccessPolicyConnection{Edges: []*AccessPolicyEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			if conn.TotalCount, err = r.Clone().Count(ctx); err != nil {
+			if conn.TotalCount, err = ap.Clone().Count(ctx); err != nil {
 				return nil, err
 			}
 			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
@@ -421,18 +425,18 @@ func (r *RoleQuery) Paginate(
 		return conn, nil
 	}
 
-	r = pager.applyCursors(r, after, before)
-	r = pager.applyOrder(r, last != nil)
+	ap = pager.applyCursors(ap, after, before)
+	ap = pager.applyOrder(ap, last != nil)
 	if limit := paginateLimit(first, last); limit != 0 {
-		r.Limit(limit)
+		ap.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := r.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := ap.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
 
-	nodes, err := r.All(ctx)
+	nodes, err := ap.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -441,138 +445,1430 @@ func (r *RoleQuery) Paginate(
 }
 
 var (
-	// RoleOrderFieldCreatedAt orders Role by created_at.
-	RoleOrderFieldCreatedAt = &RoleOrderField{
-		field: role.FieldCreatedAt,
-		toCursor: func(r *Role) Cursor {
+	// AccessPolicyOrderFieldCreatedAt orders AccessPolicy by created_at.
+	AccessPolicyOrderFieldCreatedAt = &AccessPolicyOrderField{
+		field: accesspolicy.FieldCreatedAt,
+		toCursor: func(ap *AccessPolicy) Cursor {
 			return Cursor{
-				ID:    r.ID,
-				Value: r.CreatedAt,
+				ID:    ap.ID,
+				Value: ap.CreatedAt,
 			}
 		},
 	}
-	// RoleOrderFieldUpdatedAt orders Role by updated_at.
-	RoleOrderFieldUpdatedAt = &RoleOrderField{
-		field: role.FieldUpdatedAt,
-		toCursor: func(r *Role) Cursor {
+	// AccessPolicyOrderFieldUpdatedAt orders AccessPolicy by updated_at.
+	AccessPolicyOrderFieldUpdatedAt = &AccessPolicyOrderField{
+		field: accesspolicy.FieldUpdatedAt,
+		toCursor: func(ap *AccessPolicy) Cursor {
 			return Cursor{
-				ID:    r.ID,
-				Value: r.UpdatedAt,
+				ID:    ap.ID,
+				Value: ap.UpdatedAt,
 			}
 		},
 	}
-	// RoleOrderFieldDeletedAt orders Role by deleted_at.
-	RoleOrderFieldDeletedAt = &RoleOrderField{
-		field: role.FieldDeletedAt,
-		toCursor: func(r *Role) Cursor {
+	// AccessPolicyOrderFieldDeletedAt orders AccessPolicy by deleted_at.
+	AccessPolicyOrderFieldDeletedAt = &AccessPolicyOrderField{
+		field: accesspolicy.FieldDeletedAt,
+		toCursor: func(ap *AccessPolicy) Cursor {
 			return Cursor{
-				ID:    r.ID,
-				Value: r.DeletedAt,
+				ID:    ap.ID,
+				Value: ap.DeletedAt,
 			}
 		},
 	}
-	// RoleOrderFieldName orders Role by name.
-	RoleOrderFieldName = &RoleOrderField{
-		field: role.FieldName,
-		toCursor: func(r *Role) Cursor {
+	// AccessPolicyOrderFieldName orders AccessPolicy by name.
+	AccessPolicyOrderFieldName = &AccessPolicyOrderField{
+		field: accesspolicy.FieldName,
+		toCursor: func(ap *AccessPolicy) Cursor {
 			return Cursor{
-				ID:    r.ID,
-				Value: r.Name,
+				ID:    ap.ID,
+				Value: ap.Name,
+			}
+		},
+	}
+	// AccessPolicyOrderFieldAction orders AccessPolicy by action.
+	AccessPolicyOrderFieldAction = &AccessPolicyOrderField{
+		field: accesspolicy.FieldAction,
+		toCursor: func(ap *AccessPolicy) Cursor {
+			return Cursor{
+				ID:    ap.ID,
+				Value: ap.Action,
+			}
+		},
+	}
+	// AccessPolicyOrderFieldResource orders AccessPolicy by resource.
+	AccessPolicyOrderFieldResource = &AccessPolicyOrderField{
+		field: accesspolicy.FieldResource,
+		toCursor: func(ap *AccessPolicy) Cursor {
+			return Cursor{
+				ID:    ap.ID,
+				Value: ap.Resource,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f RoleOrderField) String() string {
+func (f AccessPolicyOrderField) String() string {
 	var str string
 	switch f.field {
-	case role.FieldCreatedAt:
+	case accesspolicy.FieldCreatedAt:
 		str = "CREATED_AT"
-	case role.FieldUpdatedAt:
+	case accesspolicy.FieldUpdatedAt:
 		str = "UPDATED_AT"
-	case role.FieldDeletedAt:
+	case accesspolicy.FieldDeletedAt:
 		str = "DELETED_AT"
-	case role.FieldName:
+	case accesspolicy.FieldName:
 		str = "NAME"
+	case accesspolicy.FieldAction:
+		str = "ACTION"
+	case accesspolicy.FieldResource:
+		str = "RESOURCE"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f RoleOrderField) MarshalGQL(w io.Writer) {
+func (f AccessPolicyOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *RoleOrderField) UnmarshalGQL(v interface{}) error {
+func (f *AccessPolicyOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("RoleOrderField %T must be a string", v)
+		return fmt.Errorf("AccessPolicyOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *RoleOrderFieldCreatedAt
+		*f = *AccessPolicyOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *RoleOrderFieldUpdatedAt
+		*f = *AccessPolicyOrderFieldUpdatedAt
 	case "DELETED_AT":
-		*f = *RoleOrderFieldDeletedAt
+		*f = *AccessPolicyOrderFieldDeletedAt
 	case "NAME":
-		*f = *RoleOrderFieldName
+		*f = *AccessPolicyOrderFieldName
+	case "ACTION":
+		*f = *AccessPolicyOrderFieldAction
+	case "RESOURCE":
+		*f = *AccessPolicyOrderFieldResource
 	default:
-		return fmt.Errorf("%s is not a valid RoleOrderField", str)
+		return fmt.Errorf("%s is not a valid AccessPolicyOrderField", str)
 	}
 	return nil
 }
 
-// RoleOrderField defines the ordering field of Role.
-type RoleOrderField struct {
+// AccessPolicyOrderField defines the ordering field of AccessPolicy.
+type AccessPolicyOrderField struct {
 	field    string
-	toCursor func(*Role) Cursor
+	toCursor func(*AccessPolicy) Cursor
 }
 
-// RoleOrder defines the ordering of Role.
-type RoleOrder struct {
-	Direction OrderDirection  `json:"direction"`
-	Field     *RoleOrderField `json:"field"`
+// AccessPolicyOrder defines the ordering of AccessPolicy.
+type AccessPolicyOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *AccessPolicyOrderField `json:"field"`
 }
 
-// DefaultRoleOrder is the default ordering of Role.
-var DefaultRoleOrder = &RoleOrder{
+// DefaultAccessPolicyOrder is the default ordering of AccessPolicy.
+var DefaultAccessPolicyOrder = &AccessPolicyOrder{
 	Direction: OrderDirectionAsc,
-	Field: &RoleOrderField{
-		field: role.FieldID,
-		toCursor: func(r *Role) Cursor {
-			return Cursor{ID: r.ID}
+	Field: &AccessPolicyOrderField{
+		field: accesspolicy.FieldID,
+		toCursor: func(ap *AccessPolicy) Cursor {
+			return Cursor{ID: ap.ID}
 		},
 	},
 }
 
-// ToEdge converts Role into RoleEdge.
-func (r *Role) ToEdge(order *RoleOrder) *RoleEdge {
+// ToEdge converts AccessPolicy into AccessPolicyEdge.
+func (ap *AccessPolicy) ToEdge(order *AccessPolicyOrder) *AccessPolicyEdge {
+	if order == nil {
+		order = DefaultAccessPolicyOrder
+	}
+	return &AccessPolicyEdge{
+		Node:   ap,
+		Cursor: order.Field.toCursor(ap),
+	}
+}
+
+// AuditEventEdge is the edge representation of AuditEvent.
+type AuditEventEdge struct {
+	Node   *AuditEvent `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// AuditEventConnection is the connection containing edges to AuditEvent.
+type AuditEventConnection struct {
+	Edges      []*AuditEventEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *AuditEventConnection) build(nodes []*AuditEvent, pager *auditeventPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *AuditEvent
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *AuditEvent {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *AuditEvent {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*AuditEventEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &AuditEventEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// AuditEventPaginateOption enables pagination customization.
+type AuditEventPaginateOption func(*auditeventPager) error
+
+// WithAuditEventOrder configures pagination ordering.
+func WithAuditEventOrder(order *AuditEventOrder) AuditEventPaginateOption {
+	if order == nil {
+		order = DefaultAuditEventOrder
+	}
+	o := *order
+	return func(pager *auditeventPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultAuditEventOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithAuditEventFilter configures pagination filter.
+func WithAuditEventFilter(filter func(*AuditEventQuery) (*AuditEventQuery, error)) AuditEventPaginateOption {
+	return func(pager *auditeventPager) error {
+		if filter == nil {
+			return errors.New("AuditEventQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type auditeventPager struct {
+	order  *AuditEventOrder
+	filter func(*AuditEventQuery) (*AuditEventQuery, error)
+}
+
+func newAuditEventPager(opts []AuditEventPaginateOption) (*auditeventPager, error) {
+	pager := &auditeventPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultAuditEventOrder
+	}
+	return pager, nil
+}
+
+func (p *auditeventPager) applyFilter(query *AuditEventQuery) (*AuditEventQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *auditeventPager) toCursor(ae *AuditEvent) Cursor {
+	return p.order.Field.toCursor(ae)
+}
+
+func (p *auditeventPager) applyCursors(query *AuditEventQuery, after, before *Cursor) *AuditEventQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultAuditEventOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *auditeventPager) applyOrder(query *AuditEventQuery, reverse bool) *AuditEventQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultAuditEventOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultAuditEventOrder.Field.field))
+	}
+	return query
+}
+
+func (p *auditeventPager) orderExpr(reverse bool) sql.Querier {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAuditEventOrder.Field {
+			b.Comma().Ident(DefaultAuditEventOrder.Field.field).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to AuditEvent.
+func (ae *AuditEventQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...AuditEventPaginateOption,
+) (*AuditEventConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newAuditEventPager(opts)
+	if err != nil {
+		return nil, err
+	}
+	if ae, err = pager.applyFilter(ae); err != nil {
+		return nil, err
+	}
+	conn := &AuditEventConnection{Edges: []*AuditEventEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = ae.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+
+	ae = pager.applyCursors(ae, after, before)
+	ae = pager.applyOrder(ae, last != nil)
+	if limit := paginateLimit(first, last); limit != 0 {
+		ae.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := ae.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes, err := ae.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// AuditEventOrderFieldCreatedAt orders AuditEvent by created_at.
+	AuditEventOrderFieldCreatedAt = &AuditEventOrderField{
+		field: auditevent.FieldCreatedAt,
+		toCursor: func(ae *AuditEvent) Cursor {
+			return Cursor{
+				ID:    ae.ID,
+				Value: ae.CreatedAt,
+			}
+		},
+	}
+	// AuditEventOrderFieldEntityType orders AuditEvent by entity_type.
+	AuditEventOrderFieldEntityType = &AuditEventOrderField{
+		field: auditevent.FieldEntityType,
+		toCursor: func(ae *AuditEvent) Cursor {
+			return Cursor{
+				ID:    ae.ID,
+				Value: ae.EntityType,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f AuditEventOrderField) String() string {
+	var str string
+	switch f.field {
+	case auditevent.FieldCreatedAt:
+		str = "CREATED_AT"
+	case auditevent.FieldEntityType:
+		str = "ENTITY_TYPE"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f AuditEventOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *AuditEventOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("AuditEventOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *AuditEventOrderFieldCreatedAt
+	case "ENTITY_TYPE":
+		*f = *AuditEventOrderFieldEntityType
+	default:
+		return fmt.Errorf("%s is not a valid AuditEventOrderField", str)
+	}
+	return nil
+}
+
+// AuditEventOrderField defines the ordering field of AuditEvent.
+type AuditEventOrderField struct {
+	field    string
+	toCursor func(*AuditEvent) Cursor
+}
+
+// AuditEventOrder defines the ordering of AuditEvent.
+type AuditEventOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *AuditEventOrderField `json:"field"`
+}
+
+// DefaultAuditEventOrder is the default ordering of AuditEvent.
+var DefaultAuditEventOrder = &AuditEventOrder{
+	Direction: OrderDirectionAsc,
+	Field: &AuditEventOrderField{
+		field: auditevent.FieldID,
+		toCursor: func(ae *AuditEvent) Cursor {
+			return Cursor{ID: ae.ID}
+		},
+	},
+}
+
+// ToEdge converts AuditEvent into AuditEventEdge.
+func (ae *AuditEvent) ToEdge(order *AuditEventOrder) *AuditEventEdge {
+	if order == nil {
+		order = DefaultAuditEventOrder
+	}
+	return &AuditEventEdge{
+		Node:   ae,
+		Cursor: order.Field.toCursor(ae),
+	}
+}
+
+// RoleEdge is the edge representation of Role.
+type RoleEdge struct {
+	Node   *Role  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// RoleConnection is the connection containing edges to Role.
+type RoleConnection struct {
+	Edges      []*RoleEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+func (c *RoleConnection) build(nodes []*Role, pager *rolePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Role
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Role {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Role {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*RoleEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &RoleEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// RolePaginateOption enables pagination customization.
+type RolePaginateOption func(*rolePager) error
+
+// WithRoleOrder configures pagination ordering.
+func WithRoleOrder(order *RoleOrder) RolePaginateOption {
+	if order == nil {
+		order = DefaultRoleOrder
+	}
+	o := *order
+	return func(pager *rolePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultRoleOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithRoleFilter configures pagination filter.
+func WithRoleFilter(filter func(*RoleQuery) (*RoleQuery, error)) RolePaginateOption {
+	return func(pager *rolePager) error {
+		if filter == nil {
+			return errors.New("RoleQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type rolePager struct {
+	order  *RoleOrder
+	filter func(*RoleQuery) (*RoleQuery, error)
+}
+
+func newRolePager(opts []RolePaginateOption) (*rolePager, error) {
+	pager := &rolePager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultRoleOrder
+	}
+	return pager, nil
+}
+
+func (p *rolePager) applyFilter(query *RoleQuery) (*RoleQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *rolePager) toCursor(r *Role) Cursor {
+	return p.order.Field.toCursor(r)
+}
+
+func (p *rolePager) applyCursors(query *RoleQuery, after, before *Cursor) *RoleQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultRoleOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *rolePager) applyOrder(query *RoleQuery, reverse bool) *RoleQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultRoleOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultRoleOrder.Field.field))
+	}
+	return query
+}
+
+func (p *rolePager) orderExpr(reverse bool) sql.Querier {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultRoleOrder.Field {
+			b.Comma().Ident(DefaultRoleOrder.Field.field).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Role.
+func (r *RoleQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...RolePaginateOption,
+) (*RoleConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newRolePager(opts)
+	if err != nil {
+		return nil, err
+	}
+	if r, err = pager.applyFilter(r); err != nil {
+		return nil, err
+	}
+	conn := &RoleConnection{Edges: []*RoleEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = r.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+
+	r = pager.applyCursors(r, after, before)
+	r = pager.applyOrder(r, last != nil)
+	if limit := paginateLimit(first, last); limit != 0 {
+		r.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := r.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes, err := r.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// RoleOrderFieldCreatedAt orders Role by created_at.
+	RoleOrderFieldCreatedAt = &RoleOrderField{
+		field: role.FieldCreatedAt,
+		toCursor: func(r *Role) Cursor {
+			return Cursor{
+				ID:    r.ID,
+				Value: r.CreatedAt,
+			}
+		},
+	}
+	// RoleOrderFieldUpdatedAt orders Role by updated_at.
+	RoleOrderFieldUpdatedAt = &RoleOrderField{
+		field: role.FieldUpdatedAt,
+		toCursor: func(r *Role) Cursor {
+			return Cursor{
+				ID:    r.ID,
+				Value: r.UpdatedAt,
+			}
+		},
+	}
+	// RoleOrderFieldDeletedAt orders Role by deleted_at.
+	RoleOrderFieldDeletedAt = &RoleOrderField{
+		field: role.FieldDeletedAt,
+		toCursor: func(r *Role) Cursor {
+			return Cursor{
+				ID:    r.ID,
+				Value: r.DeletedAt,
+			}
+		},
+	}
+	// RoleOrderFieldName orders Role by name.
+	RoleOrderFieldName = &RoleOrderField{
+		field: role.FieldName,
+		toCursor: func(r *Role) Cursor {
+			return Cursor{
+				ID:    r.ID,
+				Value: r.Name,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f RoleOrderField) String() string {
+	var str string
+	switch f.field {
+	case role.FieldCreatedAt:
+		str = "CREATED_AT"
+	case role.FieldUpdatedAt:
+		str = "UPDATED_AT"
+	case role.FieldDeletedAt:
+		str = "DELETED_AT"
+	case role.FieldName:
+		str = "NAME"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f RoleOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *RoleOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("RoleOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *RoleOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *RoleOrderFieldUpdatedAt
+	case "DELETED_AT":
+		*f = *RoleOrderFieldDeletedAt
+	case "NAME":
+		*f = *RoleOrderFieldName
+	default:
+		return fmt.Errorf("%s is not a valid RoleOrderField", str)
+	}
+	return nil
+}
+
+// RoleOrderField defines the ordering field of Role.
+type RoleOrderField struct {
+	field    string
+	toCursor func(*Role) Cursor
+}
+
+// RoleOrder defines the ordering of Role.
+type RoleOrder struct {
+	Direction OrderDirection  `json:"direction"`
+	Field     *RoleOrderField `json:"field"`
+}
+
+// DefaultRoleOrder is the default ordering of Role.
+var DefaultRoleOrder = &RoleOrder{
+	Direction: OrderDirectionAsc,
+	Field: &RoleOrderField{
+		field: role.FieldID,
+		toCursor: func(r *Role) Cursor {
+			return Cursor{ID: r.ID}
+		},
+	},
+}
+
+// ToEdge converts Role into RoleEdge.
+func (r *Role) ToEdge(order *RoleOrder) *RoleEdge {
+	if order == nil {
+		order = DefaultRoleOrder
+	}
+	return &RoleEdge{
+		Node:   r,
+		Cursor: order.Field.toCursor(r),
+	}
+}
+
+// UserEdge is the edge representation of User.
+type UserEdge struct {
+	Node   *User  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// UserConnection is the connection containing edges to User.
+type UserConnection struct {
+	Edges      []*UserEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+func (c *UserConnection) build(nodes []*User, pager *userPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *User
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *User {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *User {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*UserEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &UserEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// UserPaginateOption enables pagination customization.
+type UserPaginateOption func(*userPager) error
+
+// WithUserOrder configures pagination ordering.
+func WithUserOrder(order *UserOrder) UserPaginateOption {
+	if order == nil {
+		order = DefaultUserOrder
+	}
+	o := *order
+	return func(pager *userPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultUserOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithUserFilter configures pagination filter.
+func WithUserFilter(filter func(*UserQuery) (*UserQuery, error)) UserPaginateOption {
+	return func(pager *userPager) error {
+		if filter == nil {
+			return errors.New("UserQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type userPager struct {
+	order  *UserOrder
+	filter func(*UserQuery) (*UserQuery, error)
+}
+
+func newUserPager(opts []UserPaginateOption) (*userPager, error) {
+	pager := &userPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultUserOrder
+	}
+	return pager, nil
+}
+
+func (p *userPager) applyFilter(query *UserQuery) (*UserQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *userPager) toCursor(u *User) Cursor {
+	return p.order.Field.toCursor(u)
+}
+
+func (p *userPager) applyCursors(query *UserQuery, after, before *Cursor) *UserQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultUserOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *userPager) applyOrder(query *UserQuery, reverse bool) *UserQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultUserOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultUserOrder.Field.field))
+	}
+	return query
+}
+
+func (p *userPager) orderExpr(reverse bool) sql.Querier {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultUserOrder.Field {
+			b.Comma().Ident(DefaultUserOrder.Field.field).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to User.
+func (u *UserQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...UserPaginateOption,
+) (*UserConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newUserPager(opts)
+	if err != nil {
+		return nil, err
+	}
+	if u, err = pager.applyFilter(u); err != nil {
+		return nil, err
+	}
+	conn := &UserConnection{Edges: []*UserEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = u.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+
+	u = pager.applyCursors(u, after, before)
+	u = pager.applyOrder(u, last != nil)
+	if limit := paginateLimit(first, last); limit != 0 {
+		u.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := u.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes, err := u.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// UserOrderFieldCreatedAt orders User by created_at.
+	UserOrderFieldCreatedAt = &UserOrderField{
+		field: user.FieldCreatedAt,
+		toCursor: func(u *User) Cursor {
+			return Cursor{
+				ID:    u.ID,
+				Value: u.CreatedAt,
+			}
+		},
+	}
+	// UserOrderFieldUpdatedAt orders User by updated_at.
+	UserOrderFieldUpdatedAt = &UserOrderField{
+		field: user.FieldUpdatedAt,
+		toCursor: func(u *User) Cursor {
+			return Cursor{
+				ID:    u.ID,
+				Value: u.UpdatedAt,
+			}
+		},
+	}
+	// UserOrderFieldDeletedAt orders User by deleted_at.
+	UserOrderFieldDeletedAt = &UserOrderField{
+		field: user.FieldDeletedAt,
+		toCursor: func(u *User) Cursor {
+			return Cursor{
+				ID:    u.ID,
+				Value: u.DeletedAt,
+			}
+		},
+	}
+	// UserOrderFieldName orders User by name.
+	UserOrderFieldName = &UserOrderField{
+		field: user.FieldName,
+		toCursor: func(u *User) Cursor {
+			return Cursor{
+				ID:    u.ID,
+				Value: u.Name,
+			}
+		},
+	}
+	// UserOrderFieldPhone orders User by phone.
+	UserOrderFieldPhone = &UserOrderField{
+		field: user.FieldPhone,
+		toCursor: func(u *User) Cursor {
+			return Cursor{
+				ID:    u.ID,
+				Value: u.Phone,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f UserOrderField) String() string {
+	var str string
+	switch f.field {
+	case user.FieldCreatedAt:
+		str = "CREATED_AT"
+	case user.FieldUpdatedAt:
+		str = "UPDATED_AT"
+	case user.FieldDeletedAt:
+		str = "DELETED_AT"
+	case user.FieldName:
+		str = "NAME"
+	case user.FieldPhone:
+		str = "PHONE"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f UserOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *UserOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("UserOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *UserOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *UserOrderFieldUpdatedAt
+	case "DELETED_AT":
+		*f = *UserOrderFieldDeletedAt
+	case "NAME":
+		*f = *UserOrderFieldName
+	case "PHONE":
+		*f = *UserOrderFieldPhone
+	default:
+		return fmt.Errorf("%s is not a valid UserOrderField", str)
+	}
+	return nil
+}
+
+// UserOrderField defines the ordering field of User.
+type UserOrderField struct {
+	field    string
+	toCursor func(*User) Cursor
+}
+
+// UserOrder defines the ordering of User.
+type UserOrder struct {
+	Direction OrderDirection  `json:"direction"`
+	Field     *UserOrderField `json:"field"`
+}
+
+// DefaultUserOrder is the default ordering of User.
+var DefaultUserOrder = &UserOrder{
+	Direction: OrderDirectionAsc,
+	Field: &UserOrderField{
+		field: user.FieldID,
+		toCursor: func(u *User) Cursor {
+			return Cursor{ID: u.ID}
+		},
+	},
+}
+
+// ToEdge converts User into UserEdge.
+func (u *User) ToEdge(order *UserOrder) *UserEdge {
+	if order == nil {
+		order = DefaultUserOrder
+	}
+	return &UserEdge{
+		Node:   u,
+		Cursor: order.Field.toCursor(u),
+	}
+}
+
+// UserRoleEdge is the edge representation of UserRole.
+type UserRoleEdge struct {
+	Node   *UserRole `json:"node"`
+	Cursor Cursor    `json:"cursor"`
+}
+
+// UserRoleConnection is the connection containing edges to UserRole.
+type UserRoleConnection struct {
+	Edges      []*UserRoleEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+func (c *UserRoleConnection) build(nodes []*UserRole, pager *userrolePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *UserRole
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *UserRole {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *UserRole {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*UserRoleEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &UserRoleEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// UserRolePaginateOption enables pagination customization.
+type UserRolePaginateOption func(*userrolePager) error
+
+// WithUserRoleOrder configures pagination ordering.
+func WithUserRoleOrder(order *UserRoleOrder) UserRolePaginateOption {
+	if order == nil {
+		order = DefaultUserRoleOrder
+	}
+	o := *order
+	return func(pager *userrolePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultUserRoleOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithUserRoleFilter configures pagination filter.
+func WithUserRoleFilter(filter func(*UserRoleQuery) (*UserRoleQuery, error)) UserRolePaginateOption {
+	return func(pager *userrolePager) error {
+		if filter == nil {
+			return errors.New("UserRoleQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type userrolePager struct {
+	order  *UserRoleOrder
+	filter func(*UserRoleQuery) (*UserRoleQuery, error)
+}
+
+func newUserRolePager(opts []UserRolePaginateOption) (*userrolePager, error) {
+	pager := &userrolePager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultUserRoleOrder
+	}
+	return pager, nil
+}
+
+func (p *userrolePager) applyFilter(query *UserRoleQuery) (*UserRoleQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *userrolePager) toCursor(ur *UserRole) Cursor {
+	return p.order.Field.toCursor(ur)
+}
+
+func (p *userrolePager) applyCursors(query *UserRoleQuery, after, before *Cursor) *UserRoleQuery {
+	for _, predicate := range cursorsToPredicates(
+		p.order.Direction, after, before,
+		p.order.Field.field, DefaultUserRoleOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *userrolePager) applyOrder(query *UserRoleQuery, reverse bool) *UserRoleQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.field))
+	if p.order.Field != DefaultUserRoleOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultUserRoleOrder.Field.field))
+	}
+	return query
+}
+
+func (p *userrolePager) orderExpr(reverse bool) sql.Querier {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultUserRoleOrder.Field {
+			b.Comma().Ident(DefaultUserRoleOrder.Field.field).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to UserRole.
+func (ur *UserRoleQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...UserRolePaginateOption,
+) (*UserRoleConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newUserRolePager(opts)
+	if err != nil {
+		return nil, err
+	}
+	if ur, err = pager.applyFilter(ur); err != nil {
+		return nil, err
+	}
+	conn := &UserRoleConnection{Edges: []*UserRoleEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = ur.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+
+	ur = pager.applyCursors(ur, after, before)
+	ur = pager.applyOrder(ur, last != nil)
+	if limit := paginateLimit(first, last); limit != 0 {
+		ur.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := ur.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes, err := ur.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// UserRoleOrderFieldCreatedAt orders UserRole by created_at.
+	UserRoleOrderFieldCreatedAt = &UserRoleOrderField{
+		field: userrole.FieldCreatedAt,
+		toCursor: func(ur *UserRole) Cursor {
+			return Cursor{
+				ID:    ur.ID,
+				Value: ur.CreatedAt,
+			}
+		},
+	}
+	// UserRoleOrderFieldUpdatedAt orders UserRole by updated_at.
+	UserRoleOrderFieldUpdatedAt = &UserRoleOrderField{
+		field: userrole.FieldUpdatedAt,
+		toCursor: func(ur *UserRole) Cursor {
+			return Cursor{
+				ID:    ur.ID,
+				Value: ur.UpdatedAt,
+			}
+		},
+	}
+	// UserRoleOrderFieldDeletedAt orders UserRole by deleted_at.
+	UserRoleOrderFieldDeletedAt = &UserRoleOrderField{
+		field: userrole.FieldDeletedAt,
+		toCursor: func(ur *UserRole) Cursor {
+			return Cursor{
+				ID:    ur.ID,
+				Value: ur.DeletedAt,
+			}
+		},
+	}
+	// UserRoleOrderFieldValidFrom orders UserRole by valid_from.
+	UserRoleOrderFieldValidFrom = &UserRoleOrderField{
+		field: userrole.FieldValidFrom,
+		toCursor: func(ur *UserRole) Cursor {
+			return Cursor{
+				ID:    ur.ID,
+				Value: ur.ValidFrom,
+			}
+		},
+	}
+	// UserRoleOrderFieldValidUntil orders UserRole by valid_until.
+	UserRoleOrderFieldValidUntil = &UserRoleOrderField{
+		field: userrole.FieldValidUntil,
+		toCursor: func(ur *UserRole) Cursor {
+			return Cursor{
+				ID:    ur.ID,
+				Value: ur.ValidUntil,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f UserRoleOrderField) String() string {
+	var str string
+	switch f.field {
+	case userrole.FieldCreatedAt:
+		str = "CREATED_AT"
+	case userrole.FieldUpdatedAt:
+		str = "UPDATED_AT"
+	case userrole.FieldDeletedAt:
+		str = "DELETED_AT"
+	case userrole.FieldValidFrom:
+		str = "VALID_FROM"
+	case userrole.FieldValidUntil:
+		str = "VALID_UNTIL"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f UserRoleOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *UserRoleOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("UserRoleOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *UserRoleOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *UserRoleOrderFieldUpdatedAt
+	case "DELETED_AT":
+		*f = *UserRoleOrderFieldDeletedAt
+	case "VALID_FROM":
+		*f = *UserRoleOrderFieldValidFrom
+	case "VALID_UNTIL":
+		*f = *UserRoleOrderFieldValidUntil
+	default:
+		return fmt.Errorf("%s is not a valid UserRoleOrderField", str)
+	}
+	return nil
+}
+
+// UserRoleOrderField defines the ordering field of UserRole.
+type UserRoleOrderField struct {
+	field    string
+	toCursor func(*UserRole) Cursor
+}
+
+// UserRoleOrder defines the ordering of UserRole.
+type UserRoleOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *UserRoleOrderField `json:"field"`
+}
+
+// DefaultUserRoleOrder is the default ordering of UserRole.
+var DefaultUserRoleOrder = &UserRoleOrder{
+	Direction: OrderDirectionAsc,
+	Field: &UserRoleOrderField{
+		field: userrole.FieldID,
+		toCursor: func(ur *UserRole) Cursor {
+			return Cursor{ID: ur.ID}
+		},
+	},
+}
+
+// ToEdge converts UserRole into UserRoleEdge.
+func (ur *UserRole) ToEdge(order *UserRoleOrder) *UserRoleEdge {
 	if order == nil {
-		order = DefaultRoleOrder
+		order = DefaultUserRoleOrder
 	}
-	return &RoleEdge{
-		Node:   r,
-		Cursor: order.Field.toCursor(r),
+	return &UserRoleEdge{
+		Node:   ur,
+		Cursor: order.Field.toCursor(ur),
 	}
 }
 
-// UserEdge is the edge representation of User.
-type UserEdge struct {
-	Node   *User  `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// WebhookDeliveryEdge is the edge representation of WebhookDelivery.
+type WebhookDeliveryEdge struct {
+	Node   *WebhookDelivery `json:"node"`
+	Cursor Cursor           `json:"cursor"`
 }
 
-// UserConnection is the connection containing edges to User.
-type UserConnection struct {
-	Edges      []*UserEdge `json:"edges"`
-	PageInfo   PageInfo    `json:"pageInfo"`
-	TotalCount int         `json:"totalCount"`
+// WebhookDeliveryConnection is the connection containing edges to WebhookDelivery.
+type WebhookDeliveryConnection struct {
+	Edges      []*WebhookDeliveryEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
 }
 
-func (c *UserConnection) build(nodes []*User, pager *userPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *WebhookDeliveryConnection) build(nodes []*WebhookDelivery, pager *webhookdeliveryPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -582,21 +1878,21 @@ func (c *UserConnection) build(nodes []*User, pager *userPager, after *Cursor, f
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *User
+	var nodeAt func(int) *WebhookDelivery
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *User {
+		nodeAt = func(i int) *WebhookDelivery {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *User {
+		nodeAt = func(i int) *WebhookDelivery {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*UserEdge, len(nodes))
+	c.Edges = make([]*WebhookDeliveryEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &UserEdge{
+		c.Edges[i] = &WebhookDeliveryEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -610,123 +1906,123 @@ func (c *UserConnection) build(nodes []*User, pager *userPager, after *Cursor, f
 	}
 }
 
-// UserPaginateOption enables pagination customization.
-type UserPaginateOption func(*userPager) error
+// WebhookDeliveryPaginateOption enables pagination customization.
+type WebhookDeliveryPaginateOption func(*webhookdeliveryPager) error
 
-// WithUserOrder configures pagination ordering.
-func WithUserOrder(order *UserOrder) UserPaginateOption {
+// WithWebhookDeliveryOrder configures pagination ordering.
+func WithWebhookDeliveryOrder(order *WebhookDeliveryOrder) WebhookDeliveryPaginateOption {
 	if order == nil {
-		order = DefaultUserOrder
+		order = DefaultWebhookDeliveryOrder
 	}
 	o := *order
-	return func(pager *userPager) error {
+	return func(pager *webhookdeliveryPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultUserOrder.Field
+			o.Field = DefaultWebhookDeliveryOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithUserFilter configures pagination filter.
-func WithUserFilter(filter func(*UserQuery) (*UserQuery, error)) UserPaginateOption {
-	return func(pager *userPager) error {
+// WithWebhookDeliveryFilter configures pagination filter.
+func WithWebhookDeliveryFilter(filter func(*WebhookDeliveryQuery) (*WebhookDeliveryQuery, error)) WebhookDeliveryPaginateOption {
+	return func(pager *webhookdeliveryPager) error {
 		if filter == nil {
-			return errors.New("UserQuery filter cannot be nil")
+			return errors.New("WebhookDeliveryQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type userPager struct {
-	order  *UserOrder
-	filter func(*UserQuery) (*UserQuery, error)
+type webhookdeliveryPager struct {
+	order  *WebhookDeliveryOrder
+	filter func(*WebhookDeliveryQuery) (*WebhookDeliveryQuery, error)
 }
 
-func newUserPager(opts []UserPaginateOption) (*userPager, error) {
-	pager := &userPager{}
+func newWebhookDeliveryPager(opts []WebhookDeliveryPaginateOption) (*webhookdeliveryPager, error) {
+	pager := &webhookdeliveryPager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultUserOrder
+		pager.order = DefaultWebhookDeliveryOrder
 	}
 	return pager, nil
 }
 
-func (p *userPager) applyFilter(query *UserQuery) (*UserQuery, error) {
+func (p *webhookdeliveryPager) applyFilter(query *WebhookDeliveryQuery) (*WebhookDeliveryQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *userPager) toCursor(u *User) Cursor {
-	return p.order.Field.toCursor(u)
+func (p *webhookdeliveryPager) toCursor(wd *WebhookDelivery) Cursor {
+	return p.order.Field.toCursor(wd)
 }
 
-func (p *userPager) applyCursors(query *UserQuery, after, before *Cursor) *UserQuery {
+func (p *webhookdeliveryPager) applyCursors(query *WebhookDeliveryQuery, after, before *Cursor) *WebhookDeliveryQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultUserOrder.Field.field,
+		p.order.Field.field, DefaultWebhookDeliveryOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *userPager) applyOrder(query *UserQuery, reverse bool) *UserQuery {
+func (p *webhookdeliveryPager) applyOrder(query *WebhookDeliveryQuery, reverse bool) *WebhookDeliveryQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultUserOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultUserOrder.Field.field))
+	if p.order.Field != DefaultWebhookDeliveryOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultWebhookDeliveryOrder.Field.field))
 	}
 	return query
 }
 
-func (p *userPager) orderExpr(reverse bool) sql.Querier {
+func (p *webhookdeliveryPager) orderExpr(reverse bool) sql.Querier {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultUserOrder.Field {
-			b.Comma().Ident(DefaultUserOrder.Field.field).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultWebhookDeliveryOrder.Field {
+			b.Comma().Ident(DefaultWebhookDeliveryOrder.Field.field).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to User.
-func (u *UserQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to WebhookDelivery.
+func (wd *WebhookDeliveryQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...UserPaginateOption,
-) (*UserConnection, error) {
+	before *Cursor, last *int, opts ...WebhookDeliveryPaginateOption,
+) (*WebhookDeliveryConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newUserPager(opts)
+	pager, err := newWebhookDeliveryPager(opts)
 	if err != nil {
 		return nil, err
 	}
-	if u, err = pager.applyFilter(u); err != nil {
+	if wd, err = pager.applyFilter(wd); err != nil {
 		return nil, err
 	}
-	conn := &UserConnection{Edges: []*UserEdge{}}
+	conn := &WebhookDeliveryConnection{Edges: []*WebhookDeliveryEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			if conn.TotalCount, err = u.Clone().Count(ctx); err != nil {
+			if conn.TotalCount, err = wd.Clone().Count(ctx); err != nil {
 				return nil, err
 			}
 			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
@@ -737,18 +2033,18 @@ func (u *UserQuery) Paginate(
 		return conn, nil
 	}
 
-	u = pager.applyCursors(u, after, before)
-	u = pager.applyOrder(u, last != nil)
+	wd = pager.applyCursors(wd, after, before)
+	wd = pager.applyOrder(wd, last != nil)
 	if limit := paginateLimit(first, last); limit != 0 {
-		u.Limit(limit)
+		wd.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := u.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := wd.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
 
-	nodes, err := u.All(ctx)
+	nodes, err := wd.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -757,152 +2053,124 @@ func (u *UserQuery) Paginate(
 }
 
 var (
-	// UserOrderFieldCreatedAt orders User by created_at.
-	UserOrderFieldCreatedAt = &UserOrderField{
-		field: user.FieldCreatedAt,
-		toCursor: func(u *User) Cursor {
-			return Cursor{
-				ID:    u.ID,
-				Value: u.CreatedAt,
-			}
-		},
-	}
-	// UserOrderFieldUpdatedAt orders User by updated_at.
-	UserOrderFieldUpdatedAt = &UserOrderField{
-		field: user.FieldUpdatedAt,
-		toCursor: func(u *User) Cursor {
+	// WebhookDeliveryOrderFieldCreatedAt orders WebhookDelivery by created_at.
+	WebhookDeliveryOrderFieldCreatedAt = &WebhookDeliveryOrderField{
+		field: webhookdelivery.FieldCreatedAt,
+		toCursor: func(wd *WebhookDelivery) Cursor {
 			return Cursor{
-				ID:    u.ID,
-				Value: u.UpdatedAt,
-			}
-		},
-	}
-	// UserOrderFieldDeletedAt orders User by deleted_at.
-	UserOrderFieldDeletedAt = &UserOrderField{
-		field: user.FieldDeletedAt,
-		toCursor: func(u *User) Cursor {
-			return Cursor{
-				ID:    u.ID,
-				Value: u.DeletedAt,
+				ID:    wd.ID,
+				Value: wd.CreatedAt,
 			}
 		},
 	}
-	// UserOrderFieldName orders User by name.
-	UserOrderFieldName = &UserOrderField{
-		field: user.FieldName,
-		toCursor: func(u *User) Cursor {
+	// WebhookDeliveryOrderFieldStatus orders WebhookDelivery by status.
+	WebhookDeliveryOrderFieldStatus = &WebhookDeliveryOrderField{
+		field: webhookdelivery.FieldStatus,
+		toCursor: func(wd *WebhookDelivery) Cursor {
 			return Cursor{
-				ID:    u.ID,
-				Value: u.Name,
+				ID:    wd.ID,
+				Value: wd.Status,
 			}
 		},
 	}
-	// UserOrderFieldPhone orders User by phone.
-	UserOrderFieldPhone = &UserOrderField{
-		field: user.FieldPhone,
-		toCursor: func(u *User) Cursor {
+	// WebhookDeliveryOrderFieldNextAttemptAt orders WebhookDelivery by next_attempt_at.
+	WebhookDeliveryOrderFieldNextAttemptAt = &WebhookDeliveryOrderField{
+		field: webhookdelivery.FieldNextAttemptAt,
+		toCursor: func(wd *WebhookDelivery) Cursor {
 			return Cursor{
-				ID:    u.ID,
-				Value: u.Phone,
+				ID:    wd.ID,
+				Value: wd.NextAttemptAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f UserOrderField) String() string {
+func (f WebhookDeliveryOrderField) String() string {
 	var str string
 	switch f.field {
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		str = "CREATED_AT"
-	case user.FieldUpdatedAt:
-		str = "UPDATED_AT"
-	case user.FieldDeletedAt:
-		str = "DELETED_AT"
-	case user.FieldName:
-		str = "NAME"
-	case user.FieldPhone:
-		str = "PHONE"
+	case webhookdelivery.FieldStatus:
+		str = "STATUS"
+	case webhookdelivery.FieldNextAttemptAt:
+		str = "NEXT_ATTEMPT_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f UserOrderField) MarshalGQL(w io.Writer) {
+func (f WebhookDeliveryOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *UserOrderField) UnmarshalGQL(v interface{}) error {
+func (f *WebhookDeliveryOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("UserOrderField %T must be a string", v)
+		return fmt.Errorf("WebhookDeliveryOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *UserOrderFieldCreatedAt
-	case "UPDATED_AT":
-		*f = *UserOrderFieldUpdatedAt
-	case "DELETED_AT":
-		*f = *UserOrderFieldDeletedAt
-	case "NAME":
-		*f = *UserOrderFieldName
-	case "PHONE":
-		*f = *UserOrderFieldPhone
+		*f = *WebhookDeliveryOrderFieldCreatedAt
+	case "STATUS":
+		*f = *WebhookDeliveryOrderFieldStatus
+	case "NEXT_ATTEMPT_AT":
+		*f = *WebhookDeliveryOrderFieldNextAttemptAt
 	default:
-		return fmt.Errorf("%s is not a valid UserOrderField", str)
+		return fmt.Errorf("%s is not a valid WebhookDeliveryOrderField", str)
 	}
 	return nil
 }
 
-// UserOrderField defines the ordering field of User.
-type UserOrderField struct {
+// WebhookDeliveryOrderField defines the ordering field of WebhookDelivery.
+type WebhookDeliveryOrderField struct {
 	field    string
-	toCursor func(*User) Cursor
+	toCursor func(*WebhookDelivery) Cursor
 }
 
-// UserOrder defines the ordering of User.
-type UserOrder struct {
-	Direction OrderDirection  `json:"direction"`
-	Field     *UserOrderField `json:"field"`
+// WebhookDeliveryOrder defines the ordering of WebhookDelivery.
+type WebhookDeliveryOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *WebhookDeliveryOrderField `json:"field"`
 }
 
-// DefaultUserOrder is the default ordering of User.
-var DefaultUserOrder = &UserOrder{
+// DefaultWebhookDeliveryOrder is the default ordering of WebhookDelivery.
+var DefaultWebhookDeliveryOrder = &WebhookDeliveryOrder{
 	Direction: OrderDirectionAsc,
-	Field: &UserOrderField{
-		field: user.FieldID,
-		toCursor: func(u *User) Cursor {
-			return Cursor{ID: u.ID}
+	Field: &WebhookDeliveryOrderField{
+		field: webhookdelivery.FieldID,
+		toCursor: func(wd *WebhookDelivery) Cursor {
+			return Cursor{ID: wd.ID}
 		},
 	},
 }
 
-// ToEdge converts User into UserEdge.
-func (u *User) ToEdge(order *UserOrder) *UserEdge {
+// ToEdge converts WebhookDelivery into WebhookDeliveryEdge.
+func (wd *WebhookDelivery) ToEdge(order *WebhookDeliveryOrder) *WebhookDeliveryEdge {
 	if order == nil {
-		order = DefaultUserOrder
+		order = DefaultWebhookDeliveryOrder
 	}
-	return &UserEdge{
-		Node:   u,
-		Cursor: order.Field.toCursor(u),
+	return &WebhookDeliveryEdge{
+		Node:   wd,
+		Cursor: order.Field.toCursor(wd),
 	}
 }
 
-// UserRoleEdge is the edge representation of UserRole.
-type UserRoleEdge struct {
-	Node   *UserRole `json:"node"`
-	Cursor Cursor    `json:"cursor"`
+// WebhookSubscriptionEdge is the edge representation of WebhookSubscription.
+type WebhookSubscriptionEdge struct {
+	Node   *WebhookSubscription `json:"node"`
+	Cursor Cursor               `json:"cursor"`
 }
 
-// UserRoleConnection is the connection containing edges to UserRole.
-type UserRoleConnection struct {
-	Edges      []*UserRoleEdge `json:"edges"`
-	PageInfo   PageInfo        `json:"pageInfo"`
-	TotalCount int             `json:"totalCount"`
+// WebhookSubscriptionConnection is the connection containing edges to WebhookSubscription.
+type WebhookSubscriptionConnection struct {
+	Edges      []*WebhookSubscriptionEdge `json:"edges"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	TotalCount int                        `json:"totalCount"`
 }
 
-func (c *UserRoleConnection) build(nodes []*UserRole, pager *userrolePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *WebhookSubscriptionConnection) build(nodes []*WebhookSubscription, pager *webhooksubscriptionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -912,21 +2180,21 @@ func (c *UserRoleConnection) build(nodes []*UserRole, pager *userrolePager, afte
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *UserRole
+	var nodeAt func(int) *WebhookSubscription
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *UserRole {
+		nodeAt = func(i int) *WebhookSubscription {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *UserRole {
+		nodeAt = func(i int) *WebhookSubscription {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*UserRoleEdge, len(nodes))
+	c.Edges = make([]*WebhookSubscriptionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &UserRoleEdge{
+		c.Edges[i] = &WebhookSubscriptionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -940,123 +2208,123 @@ func (c *UserRoleConnection) build(nodes []*UserRole, pager *userrolePager, afte
 	}
 }
 
-// UserRolePaginateOption enables pagination customization.
-type UserRolePaginateOption func(*userrolePager) error
+// WebhookSubscriptionPaginateOption enables pagination customization.
+type WebhookSubscriptionPaginateOption func(*webhooksubscriptionPager) error
 
-// WithUserRoleOrder configures pagination ordering.
-func WithUserRoleOrder(order *UserRoleOrder) UserRolePaginateOption {
+// WithWebhookSubscriptionOrder configures pagination ordering.
+func WithWebhookSubscriptionOrder(order *WebhookSubscriptionOrder) WebhookSubscriptionPaginateOption {
 	if order == nil {
-		order = DefaultUserRoleOrder
+		order = DefaultWebhookSubscriptionOrder
 	}
 	o := *order
-	return func(pager *userrolePager) error {
+	return func(pager *webhooksubscriptionPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultUserRoleOrder.Field
+			o.Field = DefaultWebhookSubscriptionOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithUserRoleFilter configures pagination filter.
-func WithUserRoleFilter(filter func(*UserRoleQuery) (*UserRoleQuery, error)) UserRolePaginateOption {
-	return func(pager *userrolePager) error {
+// WithWebhookSubscriptionFilter configures pagination filter.
+func WithWebhookSubscriptionFilter(filter func(*WebhookSubscriptionQuery) (*WebhookSubscriptionQuery, error)) WebhookSubscriptionPaginateOption {
+	return func(pager *webhooksubscriptionPager) error {
 		if filter == nil {
-			return errors.New("UserRoleQuery filter cannot be nil")
+			return errors.New("WebhookSubscriptionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type userrolePager struct {
-	order  *UserRoleOrder
-	filter func(*UserRoleQuery) (*UserRoleQuery, error)
+type webhooksubscriptionPager struct {
+	order  *WebhookSubscriptionOrder
+	filter func(*WebhookSubscriptionQuery) (*WebhookSubscriptionQuery, error)
 }
 
-func newUserRolePager(opts []UserRolePaginateOption) (*userrolePager, error) {
-	pager := &userrolePager{}
+func newWebhookSubscriptionPager(opts []WebhookSubscriptionPaginateOption) (*webhooksubscriptionPager, error) {
+	pager := &webhooksubscriptionPager{}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultUserRoleOrder
+		pager.order = DefaultWebhookSubscriptionOrder
 	}
 	return pager, nil
 }
 
-func (p *userrolePager) applyFilter(query *UserRoleQuery) (*UserRoleQuery, error) {
+func (p *webhooksubscriptionPager) applyFilter(query *WebhookSubscriptionQuery) (*WebhookSubscriptionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *userrolePager) toCursor(ur *UserRole) Cursor {
-	return p.order.Field.toCursor(ur)
+func (p *webhooksubscriptionPager) toCursor(ws *WebhookSubscription) Cursor {
+	return p.order.Field.toCursor(ws)
 }
 
-func (p *userrolePager) applyCursors(query *UserRoleQuery, after, before *Cursor) *UserRoleQuery {
+func (p *webhooksubscriptionPager) applyCursors(query *WebhookSubscriptionQuery, after, before *Cursor) *WebhookSubscriptionQuery {
 	for _, predicate := range cursorsToPredicates(
 		p.order.Direction, after, before,
-		p.order.Field.field, DefaultUserRoleOrder.Field.field,
+		p.order.Field.field, DefaultWebhookSubscriptionOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *userrolePager) applyOrder(query *UserRoleQuery, reverse bool) *UserRoleQuery {
+func (p *webhooksubscriptionPager) applyOrder(query *WebhookSubscriptionQuery, reverse bool) *WebhookSubscriptionQuery {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultUserRoleOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultUserRoleOrder.Field.field))
+	if p.order.Field != DefaultWebhookSubscriptionOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultWebhookSubscriptionOrder.Field.field))
 	}
 	return query
 }
 
-func (p *userrolePager) orderExpr(reverse bool) sql.Querier {
+func (p *webhooksubscriptionPager) orderExpr(reverse bool) sql.Querier {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultUserRoleOrder.Field {
-			b.Comma().Ident(DefaultUserRoleOrder.Field.field).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultWebhookSubscriptionOrder.Field {
+			b.Comma().Ident(DefaultWebhookSubscriptionOrder.Field.field).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to UserRole.
-func (ur *UserRoleQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to WebhookSubscription.
+func (ws *WebhookSubscriptionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...UserRolePaginateOption,
-) (*UserRoleConnection, error) {
+	before *Cursor, last *int, opts ...WebhookSubscriptionPaginateOption,
+) (*WebhookSubscriptionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newUserRolePager(opts)
+	pager, err := newWebhookSubscriptionPager(opts)
 	if err != nil {
 		return nil, err
 	}
-	if ur, err = pager.applyFilter(ur); err != nil {
+	if ws, err = pager.applyFilter(ws); err != nil {
 		return nil, err
 	}
-	conn := &UserRoleConnection{Edges: []*UserRoleEdge{}}
+	conn := &WebhookSubscriptionConnection{Edges: []*WebhookSubscriptionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			if conn.TotalCount, err = ur.Clone().Count(ctx); err != nil {
+			if conn.TotalCount, err = ws.Clone().Count(ctx); err != nil {
 				return nil, err
 			}
 			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
@@ -1067,18 +2335,18 @@ func (ur *UserRoleQuery) Paginate(
 		return conn, nil
 	}
 
-	ur = pager.applyCursors(ur, after, before)
-	ur = pager.applyOrder(ur, last != nil)
+	ws = pager.applyCursors(ws, after, before)
+	ws = pager.applyOrder(ws, last != nil)
 	if limit := paginateLimit(first, last); limit != 0 {
-		ur.Limit(limit)
+		ws.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := ur.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := ws.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
 
-	nodes, err := ur.All(ctx)
+	nodes, err := ws.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1087,106 +2355,120 @@ func (ur *UserRoleQuery) Paginate(
 }
 
 var (
-	// UserRoleOrderFieldCreatedAt orders UserRole by created_at.
-	UserRoleOrderFieldCreatedAt = &UserRoleOrderField{
-		field: userrole.FieldCreatedAt,
-		toCursor: func(ur *UserRole) Cursor {
+	// WebhookSubscriptionOrderFieldCreatedAt orders WebhookSubscription by created_at.
+	WebhookSubscriptionOrderFieldCreatedAt = &WebhookSubscriptionOrderField{
+		field: webhooksubscription.FieldCreatedAt,
+		toCursor: func(ws *WebhookSubscription) Cursor {
 			return Cursor{
-				ID:    ur.ID,
-				Value: ur.CreatedAt,
+				ID:    ws.ID,
+				Value: ws.CreatedAt,
 			}
 		},
 	}
-	// UserRoleOrderFieldUpdatedAt orders UserRole by updated_at.
-	UserRoleOrderFieldUpdatedAt = &UserRoleOrderField{
-		field: userrole.FieldUpdatedAt,
-		toCursor: func(ur *UserRole) Cursor {
+	// WebhookSubscriptionOrderFieldUpdatedAt orders WebhookSubscription by updated_at.
+	WebhookSubscriptionOrderFieldUpdatedAt = &WebhookSubscriptionOrderField{
+		field: webhooksubscription.FieldUpdatedAt,
+		toCursor: func(ws *WebhookSubscription) Cursor {
 			return Cursor{
-				ID:    ur.ID,
-				Value: ur.UpdatedAt,
+				ID:    ws.ID,
+				Value: ws.UpdatedAt,
 			}
 		},
 	}
-	// UserRoleOrderFieldDeletedAt orders UserRole by deleted_at.
-	UserRoleOrderFieldDeletedAt = &UserRoleOrderField{
-		field: userrole.FieldDeletedAt,
-		toCursor: func(ur *UserRole) Cursor {
+	// WebhookSubscriptionOrderFieldDeletedAt orders WebhookSubscription by deleted_at.
+	WebhookSubscriptionOrderFieldDeletedAt = &WebhookSubscriptionOrderField{
+		field: webhooksubscription.FieldDeletedAt,
+		toCursor: func(ws *WebhookSubscription) Cursor {
 			return Cursor{
-				ID:    ur.ID,
-				Value: ur.DeletedAt,
+				ID:    ws.ID,
+				Value: ws.DeletedAt,
+			}
+		},
+	}
+	// WebhookSubscriptionOrderFieldName orders WebhookSubscription by name.
+	WebhookSubscriptionOrderFieldName = &WebhookSubscriptionOrderField{
+		field: webhooksubscription.FieldName,
+		toCursor: func(ws *WebhookSubscription) Cursor {
+			return Cursor{
+				ID:    ws.ID,
+				Value: ws.Name,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f UserRoleOrderField) String() string {
+func (f WebhookSubscriptionOrderField) String() string {
 	var str string
 	switch f.field {
-	case userrole.FieldCreatedAt:
+	case webhooksubscription.FieldCreatedAt:
 		str = "CREATED_AT"
-	case userrole.FieldUpdatedAt:
+	case webhooksubscription.FieldUpdatedAt:
 		str = "UPDATED_AT"
-	case userrole.FieldDeletedAt:
+	case webhooksubscription.FieldDeletedAt:
 		str = "DELETED_AT"
+	case webhooksubscription.FieldName:
+		str = "NAME"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f UserRoleOrderField) MarshalGQL(w io.Writer) {
+func (f WebhookSubscriptionOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *UserRoleOrderField) UnmarshalGQL(v interface{}) error {
+func (f *WebhookSubscriptionOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("UserRoleOrderField %T must be a string", v)
+		return fmt.Errorf("WebhookSubscriptionOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *UserRoleOrderFieldCreatedAt
+		*f = *WebhookSubscriptionOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *UserRoleOrderFieldUpdatedAt
+		*f = *WebhookSubscriptionOrderFieldUpdatedAt
 	case "DELETED_AT":
-		*f = *UserRoleOrderFieldDeletedAt
+		*f = *WebhookSubscriptionOrderFieldDeletedAt
+	case "NAME":
+		*f = *WebhookSubscriptionOrderFieldName
 	default:
-		return fmt.Errorf("%s is not a valid UserRoleOrderField", str)
+		return fmt.Errorf("%s is not a valid WebhookSubscriptionOrderField", str)
 	}
 	return nil
 }
 
-// UserRoleOrderField defines the ordering field of UserRole.
-type UserRoleOrderField struct {
+// WebhookSubscriptionOrderField defines the ordering field of WebhookSubscription.
+type WebhookSubscriptionOrderField struct {
 	field    string
-	toCursor func(*UserRole) Cursor
+	toCursor func(*WebhookSubscription) Cursor
 }
 
-// UserRoleOrder defines the ordering of UserRole.
-type UserRoleOrder struct {
-	Direction OrderDirection      `json:"direction"`
-	Field     *UserRoleOrderField `json:"field"`
+// WebhookSubscriptionOrder defines the ordering of WebhookSubscription.
+type WebhookSubscriptionOrder struct {
+	Direction OrderDirection                 `json:"direction"`
+	Field     *WebhookSubscriptionOrderField `json:"field"`
 }
 
-// DefaultUserRoleOrder is the default ordering of UserRole.
-var DefaultUserRoleOrder = &UserRoleOrder{
+// DefaultWebhookSubscriptionOrder is the default ordering of WebhookSubscription.
+var DefaultWebhookSubscriptionOrder = &WebhookSubscriptionOrder{
 	Direction: OrderDirectionAsc,
-	Field: &UserRoleOrderField{
-		field: userrole.FieldID,
-		toCursor: func(ur *UserRole) Cursor {
-			return Cursor{ID: ur.ID}
+	Field: &WebhookSubscriptionOrderField{
+		field: webhooksubscription.FieldID,
+		toCursor: func(ws *WebhookSubscription) Cursor {
+			return Cursor{ID: ws.ID}
 		},
 	},
 }
 
-// ToEdge converts UserRole into UserRoleEdge.
-func (ur *UserRole) ToEdge(order *UserRoleOrder) *UserRoleEdge {
+// ToEdge converts WebhookSubscription into WebhookSubscriptionEdge.
+func (ws *WebhookSubscription) ToEdge(order *WebhookSubscriptionOrder) *WebhookSubscriptionEdge {
 	if order == nil {
-		order = DefaultUserRoleOrder
+		order = DefaultWebhookSubscriptionOrder
 	}
-	return &UserRoleEdge{
-		Node:   ur,
-		Cursor: order.Field.toCursor(ur),
+	return &WebhookSubscriptionEdge{
+		Node:   ws,
+		Cursor: order.Field.toCursor(ws),
 	}
 }
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/stark-sim/cas/pkg/ent/relationtuple"
+)
+
+// RelationTuple is the model entity for the RelationTuple schema.
+type RelationTuple struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy int64 `json:"created_by"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy int64 `json:"updated_by"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// ObjectID holds the value of the "object_id" field.
+	ObjectID string `json:"object_id,omitempty"`
+	// Relation holds the value of the "relation" field.
+	Relation string `json:"relation,omitempty"`
+	// SubjectNamespace holds the value of the "subject_namespace" field.
+	SubjectNamespace string `json:"subject_namespace,omitempty"`
+	// SubjectID holds the value of the "subject_id" field.
+	SubjectID string `json:"subject_id,omitempty"`
+	// SubjectRelation holds the value of the "subject_relation" field.
+	SubjectRelation string `json:"subject_relation,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RelationTuple) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case relationtuple.FieldID, relationtuple.FieldCreatedBy, relationtuple.FieldUpdatedBy:
+			values[i] = new(sql.NullInt64)
+		case relationtuple.FieldNamespace, relationtuple.FieldObjectID, relationtuple.FieldRelation, relationtuple.FieldSubjectNamespace, relationtuple.FieldSubjectID, relationtuple.FieldSubjectRelation:
+			values[i] = new(sql.NullString)
+		case relationtuple.FieldCreatedAt, relationtuple.FieldUpdatedAt, relationtuple.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type RelationTuple", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RelationTuple fields.
+func (rt *RelationTuple) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case relationtuple.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rt.ID = int64(value.Int64)
+		case relationtuple.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				rt.CreatedBy = value.Int64
+			}
+		case relationtuple.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				rt.UpdatedBy = value.Int64
+			}
+		case relationtuple.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rt.CreatedAt = value.Time
+			}
+		case relationtuple.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rt.UpdatedAt = value.Time
+			}
+		case relationtuple.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				rt.DeletedAt = value.Time
+			}
+		case relationtuple.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				rt.Namespace = value.String
+			}
+		case relationtuple.FieldObjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_id", values[i])
+			} else if value.Valid {
+				rt.ObjectID = value.String
+			}
+		case relationtuple.FieldRelation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field relation", values[i])
+			} else if value.Valid {
+				rt.Relation = value.String
+			}
+		case relationtuple.FieldSubjectNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_namespace", values[i])
+			} else if value.Valid {
+				rt.SubjectNamespace = value.String
+			}
+		case relationtuple.FieldSubjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value.Valid {
+				rt.SubjectID = value.String
+			}
+		case relationtuple.FieldSubjectRelation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_relation", values[i])
+			} else if value.Valid {
+				rt.SubjectRelation = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this RelationTuple.
+// Note that you need to call RelationTuple.Unwrap() before calling this method if this RelationTuple
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *RelationTuple) Update() *RelationTupleUpdateOne {
+	return NewRelationTupleClient(rt.config).UpdateOne(rt)
+}
+
+// Unwrap unwraps the RelationTuple entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *RelationTuple) Unwrap() *RelationTuple {
+	_tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RelationTuple is not a transactional entity")
+	}
+	rt.config.driver = _tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *RelationTuple) String() string {
+	var builder strings.Builder
+	builder.WriteString("RelationTuple(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rt.ID))
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", rt.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(fmt.Sprintf("%v", rt.UpdatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(rt.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(rt.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("object_id=")
+	builder.WriteString(rt.ObjectID)
+	builder.WriteString(", ")
+	builder.WriteString("relation=")
+	builder.WriteString(rt.Relation)
+	builder.WriteString(", ")
+	builder.WriteString("subject_namespace=")
+	builder.WriteString(rt.SubjectNamespace)
+	builder.WriteString(", ")
+	builder.WriteString("subject_id=")
+	builder.WriteString(rt.SubjectID)
+	builder.WriteString(", ")
+	builder.WriteString("subject_relation=")
+	builder.WriteString(rt.SubjectRelation)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IsEntity implement fedruntime.Entity
+func (rt RelationTuple) IsEntity() {}
+
+// RelationTuples is a parsable slice of RelationTuple.
+type RelationTuples []*RelationTuple
+
+func (rt RelationTuples) config(cfg config) {
+	for _i := range rt {
+		rt[_i].config = cfg
+	}
+}
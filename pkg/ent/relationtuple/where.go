@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package relationtuple
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/stark-sim/cas/pkg/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldNamespace, v))
+}
+
+// ObjectID applies equality check predicate on the "object_id" field. It's identical to ObjectIDEQ.
+func ObjectID(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldObjectID, v))
+}
+
+// Relation applies equality check predicate on the "relation" field. It's identical to RelationEQ.
+func Relation(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldRelation, v))
+}
+
+// SubjectNamespace applies equality check predicate on the "subject_namespace" field. It's identical to SubjectNamespaceEQ.
+func SubjectNamespace(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectNamespace, v))
+}
+
+// SubjectID applies equality check predicate on the "subject_id" field. It's identical to SubjectIDEQ.
+func SubjectID(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectRelation applies equality check predicate on the "subject_relation" field. It's identical to SubjectRelationEQ.
+func SubjectRelation(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectRelation, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v int64) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// ObjectIDEQ applies the EQ predicate on the "object_id" field.
+func ObjectIDEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldObjectID, v))
+}
+
+// ObjectIDNEQ applies the NEQ predicate on the "object_id" field.
+func ObjectIDNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldObjectID, v))
+}
+
+// ObjectIDIn applies the In predicate on the "object_id" field.
+func ObjectIDIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldObjectID, vs...))
+}
+
+// ObjectIDNotIn applies the NotIn predicate on the "object_id" field.
+func ObjectIDNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldObjectID, vs...))
+}
+
+// ObjectIDGT applies the GT predicate on the "object_id" field.
+func ObjectIDGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldObjectID, v))
+}
+
+// ObjectIDGTE applies the GTE predicate on the "object_id" field.
+func ObjectIDGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldObjectID, v))
+}
+
+// ObjectIDLT applies the LT predicate on the "object_id" field.
+func ObjectIDLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldObjectID, v))
+}
+
+// ObjectIDLTE applies the LTE predicate on the "object_id" field.
+func ObjectIDLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldObjectID, v))
+}
+
+// ObjectIDContains applies the Contains predicate on the "object_id" field.
+func ObjectIDContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldObjectID, v))
+}
+
+// ObjectIDHasPrefix applies the HasPrefix predicate on the "object_id" field.
+func ObjectIDHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldObjectID, v))
+}
+
+// ObjectIDHasSuffix applies the HasSuffix predicate on the "object_id" field.
+func ObjectIDHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldObjectID, v))
+}
+
+// ObjectIDEqualFold applies the EqualFold predicate on the "object_id" field.
+func ObjectIDEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldObjectID, v))
+}
+
+// ObjectIDContainsFold applies the ContainsFold predicate on the "object_id" field.
+func ObjectIDContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldObjectID, v))
+}
+
+// RelationEQ applies the EQ predicate on the "relation" field.
+func RelationEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldRelation, v))
+}
+
+// RelationNEQ applies the NEQ predicate on the "relation" field.
+func RelationNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldRelation, v))
+}
+
+// RelationIn applies the In predicate on the "relation" field.
+func RelationIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldRelation, vs...))
+}
+
+// RelationNotIn applies the NotIn predicate on the "relation" field.
+func RelationNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldRelation, vs...))
+}
+
+// RelationGT applies the GT predicate on the "relation" field.
+func RelationGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldRelation, v))
+}
+
+// RelationGTE applies the GTE predicate on the "relation" field.
+func RelationGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldRelation, v))
+}
+
+// RelationLT applies the LT predicate on the "relation" field.
+func RelationLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldRelation, v))
+}
+
+// RelationLTE applies the LTE predicate on the "relation" field.
+func RelationLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldRelation, v))
+}
+
+// RelationContains applies the Contains predicate on the "relation" field.
+func RelationContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldRelation, v))
+}
+
+// RelationHasPrefix applies the HasPrefix predicate on the "relation" field.
+func RelationHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldRelation, v))
+}
+
+// RelationHasSuffix applies the HasSuffix predicate on the "relation" field.
+func RelationHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldRelation, v))
+}
+
+// RelationEqualFold applies the EqualFold predicate on the "relation" field.
+func RelationEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldRelation, v))
+}
+
+// RelationContainsFold applies the ContainsFold predicate on the "relation" field.
+func RelationContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldRelation, v))
+}
+
+// SubjectNamespaceEQ applies the EQ predicate on the "subject_namespace" field.
+func SubjectNamespaceEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceNEQ applies the NEQ predicate on the "subject_namespace" field.
+func SubjectNamespaceNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceIn applies the In predicate on the "subject_namespace" field.
+func SubjectNamespaceIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldSubjectNamespace, vs...))
+}
+
+// SubjectNamespaceNotIn applies the NotIn predicate on the "subject_namespace" field.
+func SubjectNamespaceNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldSubjectNamespace, vs...))
+}
+
+// SubjectNamespaceGT applies the GT predicate on the "subject_namespace" field.
+func SubjectNamespaceGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceGTE applies the GTE predicate on the "subject_namespace" field.
+func SubjectNamespaceGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceLT applies the LT predicate on the "subject_namespace" field.
+func SubjectNamespaceLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceLTE applies the LTE predicate on the "subject_namespace" field.
+func SubjectNamespaceLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceContains applies the Contains predicate on the "subject_namespace" field.
+func SubjectNamespaceContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceHasPrefix applies the HasPrefix predicate on the "subject_namespace" field.
+func SubjectNamespaceHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceHasSuffix applies the HasSuffix predicate on the "subject_namespace" field.
+func SubjectNamespaceHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceEqualFold applies the EqualFold predicate on the "subject_namespace" field.
+func SubjectNamespaceEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldSubjectNamespace, v))
+}
+
+// SubjectNamespaceContainsFold applies the ContainsFold predicate on the "subject_namespace" field.
+func SubjectNamespaceContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldSubjectNamespace, v))
+}
+
+// SubjectIDEQ applies the EQ predicate on the "subject_id" field.
+func SubjectIDEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectIDNEQ applies the NEQ predicate on the "subject_id" field.
+func SubjectIDNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldSubjectID, v))
+}
+
+// SubjectIDIn applies the In predicate on the "subject_id" field.
+func SubjectIDIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDNotIn applies the NotIn predicate on the "subject_id" field.
+func SubjectIDNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDGT applies the GT predicate on the "subject_id" field.
+func SubjectIDGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldSubjectID, v))
+}
+
+// SubjectIDGTE applies the GTE predicate on the "subject_id" field.
+func SubjectIDGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldSubjectID, v))
+}
+
+// SubjectIDLT applies the LT predicate on the "subject_id" field.
+func SubjectIDLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldSubjectID, v))
+}
+
+// SubjectIDLTE applies the LTE predicate on the "subject_id" field.
+func SubjectIDLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldSubjectID, v))
+}
+
+// SubjectIDContains applies the Contains predicate on the "subject_id" field.
+func SubjectIDContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldSubjectID, v))
+}
+
+// SubjectIDHasPrefix applies the HasPrefix predicate on the "subject_id" field.
+func SubjectIDHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldSubjectID, v))
+}
+
+// SubjectIDHasSuffix applies the HasSuffix predicate on the "subject_id" field.
+func SubjectIDHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldSubjectID, v))
+}
+
+// SubjectIDEqualFold applies the EqualFold predicate on the "subject_id" field.
+func SubjectIDEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldSubjectID, v))
+}
+
+// SubjectIDContainsFold applies the ContainsFold predicate on the "subject_id" field.
+func SubjectIDContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldSubjectID, v))
+}
+
+// SubjectRelationEQ applies the EQ predicate on the "subject_relation" field.
+func SubjectRelationEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEQ(FieldSubjectRelation, v))
+}
+
+// SubjectRelationNEQ applies the NEQ predicate on the "subject_relation" field.
+func SubjectRelationNEQ(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNEQ(FieldSubjectRelation, v))
+}
+
+// SubjectRelationIn applies the In predicate on the "subject_relation" field.
+func SubjectRelationIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldIn(FieldSubjectRelation, vs...))
+}
+
+// SubjectRelationNotIn applies the NotIn predicate on the "subject_relation" field.
+func SubjectRelationNotIn(vs ...string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldNotIn(FieldSubjectRelation, vs...))
+}
+
+// SubjectRelationGT applies the GT predicate on the "subject_relation" field.
+func SubjectRelationGT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGT(FieldSubjectRelation, v))
+}
+
+// SubjectRelationGTE applies the GTE predicate on the "subject_relation" field.
+func SubjectRelationGTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldGTE(FieldSubjectRelation, v))
+}
+
+// SubjectRelationLT applies the LT predicate on the "subject_relation" field.
+func SubjectRelationLT(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLT(FieldSubjectRelation, v))
+}
+
+// SubjectRelationLTE applies the LTE predicate on the "subject_relation" field.
+func SubjectRelationLTE(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldLTE(FieldSubjectRelation, v))
+}
+
+// SubjectRelationContains applies the Contains predicate on the "subject_relation" field.
+func SubjectRelationContains(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContains(FieldSubjectRelation, v))
+}
+
+// SubjectRelationHasPrefix applies the HasPrefix predicate on the "subject_relation" field.
+func SubjectRelationHasPrefix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasPrefix(FieldSubjectRelation, v))
+}
+
+// SubjectRelationHasSuffix applies the HasSuffix predicate on the "subject_relation" field.
+func SubjectRelationHasSuffix(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldHasSuffix(FieldSubjectRelation, v))
+}
+
+// SubjectRelationEqualFold applies the EqualFold predicate on the "subject_relation" field.
+func SubjectRelationEqualFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldEqualFold(FieldSubjectRelation, v))
+}
+
+// SubjectRelationContainsFold applies the ContainsFold predicate on the "subject_relation" field.
+func SubjectRelationContainsFold(v string) predicate.RelationTuple {
+	return predicate.RelationTuple(sql.FieldContainsFold(FieldSubjectRelation, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RelationTuple) predicate.RelationTuple {
+	return predicate.RelationTuple(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RelationTuple) predicate.RelationTuple {
+	return predicate.RelationTuple(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RelationTuple) predicate.RelationTuple {
+	return predicate.RelationTuple(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
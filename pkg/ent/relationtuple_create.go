@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stark-sim/cas/pkg/ent/relationtuple"
+)
+
+// RelationTupleCreate is the builder for creating a RelationTuple entity.
+type RelationTupleCreate struct {
+	config
+	mutation *RelationTupleMutation
+	hooks    []Hook
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rtc *RelationTupleCreate) SetCreatedBy(i int64) *RelationTupleCreate {
+	rtc.mutation.SetCreatedBy(i)
+	return rtc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rtc *RelationTupleCreate) SetNillableCreatedBy(i *int64) *RelationTupleCreate {
+	if i != nil {
+		rtc.SetCreatedBy(*i)
+	}
+	return rtc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rtc *RelationTupleCreate) SetUpdatedBy(i int64) *RelationTupleCreate {
+	rtc.mutation.SetUpdatedBy(i)
+	return rtc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rtc *RelationTupleCreate) SetNillableUpdatedBy(i *int64) *RelationTupleCreate {
+	if i != nil {
+		rtc.SetUpdatedBy(*i)
+	}
+	return rtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtc *RelationTupleCreate) SetCreatedAt(t time.Time) *RelationTupleCreate {
+	rtc.mutation.SetCreatedAt(t)
+	return rtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtc *RelationTupleCreate) SetNillableCreatedAt(t *time.Time) *RelationTupleCreate {
+	if t != nil {
+		rtc.SetCreatedAt(*t)
+	}
+	return rtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtc *RelationTupleCreate) SetUpdatedAt(t time.Time) *RelationTupleCreate {
+	rtc.mutation.SetUpdatedAt(t)
+	return rtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rtc *RelationTupleCreate) SetNillableUpdatedAt(t *time.Time) *RelationTupleCreate {
+	if t != nil {
+		rtc.SetUpdatedAt(*t)
+	}
+	return rtc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rtc *RelationTupleCreate) SetDeletedAt(t time.Time) *RelationTupleCreate {
+	rtc.mutation.SetDeletedAt(t)
+	return rtc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rtc *RelationTupleCreate) SetNillableDeletedAt(t *time.Time) *RelationTupleCreate {
+	if t != nil {
+		rtc.SetDeletedAt(*t)
+	}
+	return rtc
+}
+
+// SetNamespace sets the "namespace" field.
+func (rtc *RelationTupleCreate) SetNamespace(s string) *RelationTupleCreate {
+	rtc.mutation.SetNamespace(s)
+	return rtc
+}
+
+// SetObjectID sets the "object_id" field.
+func (rtc *RelationTupleCreate) SetObjectID(s string) *RelationTupleCreate {
+	rtc.mutation.SetObjectID(s)
+	return rtc
+}
+
+// SetRelation sets the "relation" field.
+func (rtc *RelationTupleCreate) SetRelation(s string) *RelationTupleCreate {
+	rtc.mutation.SetRelation(s)
+	return rtc
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (rtc *RelationTupleCreate) SetSubjectNamespace(s string) *RelationTupleCreate {
+	rtc.mutation.SetSubjectNamespace(s)
+	return rtc
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (rtc *RelationTupleCreate) SetSubjectID(s string) *RelationTupleCreate {
+	rtc.mutation.SetSubjectID(s)
+	return rtc
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (rtc *RelationTupleCreate) SetSubjectRelation(s string) *RelationTupleCreate {
+	rtc.mutation.SetSubjectRelation(s)
+	return rtc
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (rtc *RelationTupleCreate) SetNillableSubjectRelation(s *string) *RelationTupleCreate {
+	if s != nil {
+		rtc.SetSubjectRelation(*s)
+	}
+	return rtc
+}
+
+// SetID sets the "id" field.
+func (rtc *RelationTupleCreate) SetID(i int64) *RelationTupleCreate {
+	rtc.mutation.SetID(i)
+	return rtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rtc *RelationTupleCreate) SetNillableID(i *int64) *RelationTupleCreate {
+	if i != nil {
+		rtc.SetID(*i)
+	}
+	return rtc
+}
+
+// Mutation returns the RelationTupleMutation object of the builder.
+func (rtc *RelationTupleCreate) Mutation() *RelationTupleMutation {
+	return rtc.mutation
+}
+
+// Save creates the RelationTuple in the database.
+func (rtc *RelationTupleCreate) Save(ctx context.Context) (*RelationTuple, error) {
+	if err := rtc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*RelationTuple, RelationTupleMutation](ctx, rtc.sqlSave, rtc.mutation, rtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *RelationTupleCreate) SaveX(ctx context.Context) *RelationTuple {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtc *RelationTupleCreate) Exec(ctx context.Context) error {
+	_, err := rtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtc *RelationTupleCreate) ExecX(ctx context.Context) {
+	if err := rtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *RelationTupleCreate) defaults() error {
+	if _, ok := rtc.mutation.CreatedBy(); !ok {
+		v := relationtuple.DefaultCreatedBy
+		rtc.mutation.SetCreatedBy(v)
+	}
+	if _, ok := rtc.mutation.UpdatedBy(); !ok {
+		v := relationtuple.DefaultUpdatedBy
+		rtc.mutation.SetUpdatedBy(v)
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		if relationtuple.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized relationtuple.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := relationtuple.DefaultCreatedAt()
+		rtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rtc.mutation.UpdatedAt(); !ok {
+		if relationtuple.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized relationtuple.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := relationtuple.DefaultUpdatedAt()
+		rtc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rtc.mutation.DeletedAt(); !ok {
+		v := relationtuple.DefaultDeletedAt
+		rtc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := rtc.mutation.SubjectRelation(); !ok {
+		v := relationtuple.DefaultSubjectRelation
+		rtc.mutation.SetSubjectRelation(v)
+	}
+	if _, ok := rtc.mutation.ID(); !ok {
+		if relationtuple.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized relationtuple.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := relationtuple.DefaultID()
+		rtc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *RelationTupleCreate) check() error {
+	if _, ok := rtc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "RelationTuple.created_by"`)}
+	}
+	if _, ok := rtc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "RelationTuple.updated_by"`)}
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RelationTuple.created_at"`)}
+	}
+	if _, ok := rtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RelationTuple.updated_at"`)}
+	}
+	if _, ok := rtc.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "RelationTuple.deleted_at"`)}
+	}
+	if _, ok := rtc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "RelationTuple.namespace"`)}
+	}
+	if v, ok := rtc.mutation.Namespace(); ok {
+		if err := relationtuple.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.namespace": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.ObjectID(); !ok {
+		return &ValidationError{Name: "object_id", err: errors.New(`ent: missing required field "RelationTuple.object_id"`)}
+	}
+	if v, ok := rtc.mutation.ObjectID(); ok {
+		if err := relationtuple.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.object_id": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.Relation(); !ok {
+		return &ValidationError{Name: "relation", err: errors.New(`ent: missing required field "RelationTuple.relation"`)}
+	}
+	if v, ok := rtc.mutation.Relation(); ok {
+		if err := relationtuple.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.relation": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.SubjectNamespace(); !ok {
+		return &ValidationError{Name: "subject_namespace", err: errors.New(`ent: missing required field "RelationTuple.subject_namespace"`)}
+	}
+	if v, ok := rtc.mutation.SubjectNamespace(); ok {
+		if err := relationtuple.SubjectNamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "subject_namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_namespace": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`ent: missing required field "RelationTuple.subject_id"`)}
+	}
+	if v, ok := rtc.mutation.SubjectID(); ok {
+		if err := relationtuple.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_id": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.SubjectRelation(); !ok {
+		return &ValidationError{Name: "subject_relation", err: errors.New(`ent: missing required field "RelationTuple.subject_relation"`)}
+	}
+	return nil
+}
+
+func (rtc *RelationTupleCreate) sqlSave(ctx context.Context) (*RelationTuple, error) {
+	if err := rtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	rtc.mutation.id = &_node.ID
+	rtc.mutation.done = true
+	return _node, nil
+}
+
+func (rtc *RelationTupleCreate) createSpec() (*RelationTuple, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RelationTuple{config: rtc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: relationtuple.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: relationtuple.FieldID,
+			},
+		}
+	)
+	if id, ok := rtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rtc.mutation.CreatedBy(); ok {
+		_spec.SetField(relationtuple.FieldCreatedBy, field.TypeInt64, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := rtc.mutation.UpdatedBy(); ok {
+		_spec.SetField(relationtuple.FieldUpdatedBy, field.TypeInt64, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := rtc.mutation.CreatedAt(); ok {
+		_spec.SetField(relationtuple.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(relationtuple.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rtc.mutation.DeletedAt(); ok {
+		_spec.SetField(relationtuple.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := rtc.mutation.Namespace(); ok {
+		_spec.SetField(relationtuple.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := rtc.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuple.FieldObjectID, field.TypeString, value)
+		_node.ObjectID = value
+	}
+	if value, ok := rtc.mutation.Relation(); ok {
+		_spec.SetField(relationtuple.FieldRelation, field.TypeString, value)
+		_node.Relation = value
+	}
+	if value, ok := rtc.mutation.SubjectNamespace(); ok {
+		_spec.SetField(relationtuple.FieldSubjectNamespace, field.TypeString, value)
+		_node.SubjectNamespace = value
+	}
+	if value, ok := rtc.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuple.FieldSubjectID, field.TypeString, value)
+		_node.SubjectID = value
+	}
+	if value, ok := rtc.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuple.FieldSubjectRelation, field.TypeString, value)
+		_node.SubjectRelation = value
+	}
+	return _node, _spec
+}
+
+// RelationTupleCreateBulk is the builder for creating many RelationTuple entities in bulk.
+type RelationTupleCreateBulk struct {
+	config
+	builders []*RelationTupleCreate
+}
+
+// Save creates the RelationTuple entities in the database.
+func (rtcb *RelationTupleCreateBulk) Save(ctx context.Context) ([]*RelationTuple, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*RelationTuple, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RelationTupleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *RelationTupleCreateBulk) SaveX(ctx context.Context) []*RelationTuple {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtcb *RelationTupleCreateBulk) Exec(ctx context.Context) error {
+	_, err := rtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtcb *RelationTupleCreateBulk) ExecX(ctx context.Context) {
+	if err := rtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stark-sim/cas/pkg/ent/predicate"
+	"github.com/stark-sim/cas/pkg/ent/relationtuple"
+)
+
+// RelationTupleDelete is the builder for deleting a RelationTuple entity.
+type RelationTupleDelete struct {
+	config
+	hooks    []Hook
+	mutation *RelationTupleMutation
+}
+
+// Where appends a list predicates to the RelationTupleDelete builder.
+func (rtd *RelationTupleDelete) Where(ps ...predicate.RelationTuple) *RelationTupleDelete {
+	rtd.mutation.Where(ps...)
+	return rtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rtd *RelationTupleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, RelationTupleMutation](ctx, rtd.sqlExec, rtd.mutation, rtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtd *RelationTupleDelete) ExecX(ctx context.Context) int {
+	n, err := rtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rtd *RelationTupleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: relationtuple.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: relationtuple.FieldID,
+			},
+		},
+	}
+	if ps := rtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rtd.mutation.done = true
+	return affected, err
+}
+
+// RelationTupleDeleteOne is the builder for deleting a single RelationTuple entity.
+type RelationTupleDeleteOne struct {
+	rtd *RelationTupleDelete
+}
+
+// Exec executes the deletion query.
+func (rtdo *RelationTupleDeleteOne) Exec(ctx context.Context) error {
+	n, err := rtdo.rtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{relationtuple.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtdo *RelationTupleDeleteOne) ExecX(ctx context.Context) {
+	rtdo.rtd.ExecX(ctx)
+}
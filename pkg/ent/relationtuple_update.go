@@ -0,0 +1,568 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/stark-sim/cas/pkg/ent/predicate"
+	"github.com/stark-sim/cas/pkg/ent/relationtuple"
+)
+
+// RelationTupleUpdate is the builder for updating RelationTuple entities.
+type RelationTupleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RelationTupleMutation
+}
+
+// Where appends a list predicates to the RelationTupleUpdate builder.
+func (rtu *RelationTupleUpdate) Where(ps ...predicate.RelationTuple) *RelationTupleUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rtu *RelationTupleUpdate) SetCreatedBy(i int64) *RelationTupleUpdate {
+	rtu.mutation.ResetCreatedBy()
+	rtu.mutation.SetCreatedBy(i)
+	return rtu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rtu *RelationTupleUpdate) SetNillableCreatedBy(i *int64) *RelationTupleUpdate {
+	if i != nil {
+		rtu.SetCreatedBy(*i)
+	}
+	return rtu
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (rtu *RelationTupleUpdate) AddCreatedBy(i int64) *RelationTupleUpdate {
+	rtu.mutation.AddCreatedBy(i)
+	return rtu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rtu *RelationTupleUpdate) SetUpdatedBy(i int64) *RelationTupleUpdate {
+	rtu.mutation.ResetUpdatedBy()
+	rtu.mutation.SetUpdatedBy(i)
+	return rtu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rtu *RelationTupleUpdate) SetNillableUpdatedBy(i *int64) *RelationTupleUpdate {
+	if i != nil {
+		rtu.SetUpdatedBy(*i)
+	}
+	return rtu
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (rtu *RelationTupleUpdate) AddUpdatedBy(i int64) *RelationTupleUpdate {
+	rtu.mutation.AddUpdatedBy(i)
+	return rtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtu *RelationTupleUpdate) SetUpdatedAt(t time.Time) *RelationTupleUpdate {
+	rtu.mutation.SetUpdatedAt(t)
+	return rtu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rtu *RelationTupleUpdate) SetDeletedAt(t time.Time) *RelationTupleUpdate {
+	rtu.mutation.SetDeletedAt(t)
+	return rtu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rtu *RelationTupleUpdate) SetNillableDeletedAt(t *time.Time) *RelationTupleUpdate {
+	if t != nil {
+		rtu.SetDeletedAt(*t)
+	}
+	return rtu
+}
+
+// SetNamespace sets the "namespace" field.
+func (rtu *RelationTupleUpdate) SetNamespace(s string) *RelationTupleUpdate {
+	rtu.mutation.SetNamespace(s)
+	return rtu
+}
+
+// SetObjectID sets the "object_id" field.
+func (rtu *RelationTupleUpdate) SetObjectID(s string) *RelationTupleUpdate {
+	rtu.mutation.SetObjectID(s)
+	return rtu
+}
+
+// SetRelation sets the "relation" field.
+func (rtu *RelationTupleUpdate) SetRelation(s string) *RelationTupleUpdate {
+	rtu.mutation.SetRelation(s)
+	return rtu
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (rtu *RelationTupleUpdate) SetSubjectNamespace(s string) *RelationTupleUpdate {
+	rtu.mutation.SetSubjectNamespace(s)
+	return rtu
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (rtu *RelationTupleUpdate) SetSubjectID(s string) *RelationTupleUpdate {
+	rtu.mutation.SetSubjectID(s)
+	return rtu
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (rtu *RelationTupleUpdate) SetSubjectRelation(s string) *RelationTupleUpdate {
+	rtu.mutation.SetSubjectRelation(s)
+	return rtu
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (rtu *RelationTupleUpdate) SetNillableSubjectRelation(s *string) *RelationTupleUpdate {
+	if s != nil {
+		rtu.SetSubjectRelation(*s)
+	}
+	return rtu
+}
+
+// Mutation returns the RelationTupleMutation object of the builder.
+func (rtu *RelationTupleUpdate) Mutation() *RelationTupleMutation {
+	return rtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RelationTupleUpdate) Save(ctx context.Context) (int, error) {
+	if err := rtu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks[int, RelationTupleMutation](ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RelationTupleUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RelationTupleUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RelationTupleUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtu *RelationTupleUpdate) defaults() error {
+	if _, ok := rtu.mutation.UpdatedAt(); !ok {
+		if relationtuple.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized relationtuple.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := relationtuple.UpdateDefaultUpdatedAt()
+		rtu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *RelationTupleUpdate) check() error {
+	if v, ok := rtu.mutation.Namespace(); ok {
+		if err := relationtuple.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.namespace": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.ObjectID(); ok {
+		if err := relationtuple.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.object_id": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.Relation(); ok {
+		if err := relationtuple.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.relation": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.SubjectNamespace(); ok {
+		if err := relationtuple.SubjectNamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "subject_namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_namespace": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.SubjectID(); ok {
+		if err := relationtuple.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rtu *RelationTupleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rtu.check(); err != nil {
+		return n, err
+	}
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   relationtuple.Table,
+			Columns: relationtuple.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: relationtuple.FieldID,
+			},
+		},
+	}
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.CreatedBy(); ok {
+		_spec.SetField(relationtuple.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := rtu.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(relationtuple.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := rtu.mutation.UpdatedBy(); ok {
+		_spec.SetField(relationtuple.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := rtu.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(relationtuple.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := rtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(relationtuple.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rtu.mutation.DeletedAt(); ok {
+		_spec.SetField(relationtuple.FieldDeletedAt, field.TypeTime, value)
+	}
+	if value, ok := rtu.mutation.Namespace(); ok {
+		_spec.SetField(relationtuple.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuple.FieldObjectID, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.Relation(); ok {
+		_spec.SetField(relationtuple.FieldRelation, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.SubjectNamespace(); ok {
+		_spec.SetField(relationtuple.FieldSubjectNamespace, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuple.FieldSubjectID, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuple.FieldSubjectRelation, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relationtuple.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RelationTupleUpdateOne is the builder for updating a single RelationTuple entity.
+type RelationTupleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RelationTupleMutation
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rtuo *RelationTupleUpdateOne) SetCreatedBy(i int64) *RelationTupleUpdateOne {
+	rtuo.mutation.ResetCreatedBy()
+	rtuo.mutation.SetCreatedBy(i)
+	return rtuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rtuo *RelationTupleUpdateOne) SetNillableCreatedBy(i *int64) *RelationTupleUpdateOne {
+	if i != nil {
+		rtuo.SetCreatedBy(*i)
+	}
+	return rtuo
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (rtuo *RelationTupleUpdateOne) AddCreatedBy(i int64) *RelationTupleUpdateOne {
+	rtuo.mutation.AddCreatedBy(i)
+	return rtuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rtuo *RelationTupleUpdateOne) SetUpdatedBy(i int64) *RelationTupleUpdateOne {
+	rtuo.mutation.ResetUpdatedBy()
+	rtuo.mutation.SetUpdatedBy(i)
+	return rtuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rtuo *RelationTupleUpdateOne) SetNillableUpdatedBy(i *int64) *RelationTupleUpdateOne {
+	if i != nil {
+		rtuo.SetUpdatedBy(*i)
+	}
+	return rtuo
+}
+
+// AddUpdatedBy adds i to the "updated_by" field.
+func (rtuo *RelationTupleUpdateOne) AddUpdatedBy(i int64) *RelationTupleUpdateOne {
+	rtuo.mutation.AddUpdatedBy(i)
+	return rtuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtuo *RelationTupleUpdateOne) SetUpdatedAt(t time.Time) *RelationTupleUpdateOne {
+	rtuo.mutation.SetUpdatedAt(t)
+	return rtuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rtuo *RelationTupleUpdateOne) SetDeletedAt(t time.Time) *RelationTupleUpdateOne {
+	rtuo.mutation.SetDeletedAt(t)
+	return rtuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rtuo *RelationTupleUpdateOne) SetNillableDeletedAt(t *time.Time) *RelationTupleUpdateOne {
+	if t != nil {
+		rtuo.SetDeletedAt(*t)
+	}
+	return rtuo
+}
+
+// SetNamespace sets the "namespace" field.
+func (rtuo *RelationTupleUpdateOne) SetNamespace(s string) *RelationTupleUpdateOne {
+	rtuo.mutation.SetNamespace(s)
+	return rtuo
+}
+
+// SetObjectID sets the "object_id" field.
+func (rtuo *RelationTupleUpdateOne) SetObjectID(s string) *RelationTupleUpdateOne {
+	rtuo.mutation.SetObjectID(s)
+	return rtuo
+}
+
+// SetRelation sets the "relation" field.
+func (rtuo *RelationTupleUpdateOne) SetRelation(s string) *RelationTupleUpdateOne {
+	rtuo.mutation.SetRelation(s)
+	return rtuo
+}
+
+// SetSubjectNamespace sets the "subject_namespace" field.
+func (rtuo *RelationTupleUpdateOne) SetSubjectNamespace(s string) *RelationTupleUpdateOne {
+	rtuo.mutation.SetSubjectNamespace(s)
+	return rtuo
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (rtuo *RelationTupleUpdateOne) SetSubjectID(s string) *RelationTupleUpdateOne {
+	rtuo.mutation.SetSubjectID(s)
+	return rtuo
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (rtuo *RelationTupleUpdateOne) SetSubjectRelation(s string) *RelationTupleUpdateOne {
+	rtuo.mutation.SetSubjectRelation(s)
+	return rtuo
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (rtuo *RelationTupleUpdateOne) SetNillableSubjectRelation(s *string) *RelationTupleUpdateOne {
+	if s != nil {
+		rtuo.SetSubjectRelation(*s)
+	}
+	return rtuo
+}
+
+// Mutation returns the RelationTupleMutation object of the builder.
+func (rtuo *RelationTupleUpdateOne) Mutation() *RelationTupleMutation {
+	return rtuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RelationTupleUpdateOne) Select(field string, fields ...string) *RelationTupleUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RelationTuple entity.
+func (rtuo *RelationTupleUpdateOne) Save(ctx context.Context) (*RelationTuple, error) {
+	if err := rtuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks[*RelationTuple, RelationTupleMutation](ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RelationTupleUpdateOne) SaveX(ctx context.Context) *RelationTuple {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RelationTupleUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RelationTupleUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtuo *RelationTupleUpdateOne) defaults() error {
+	if _, ok := rtuo.mutation.UpdatedAt(); !ok {
+		if relationtuple.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized relationtuple.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := relationtuple.UpdateDefaultUpdatedAt()
+		rtuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *RelationTupleUpdateOne) check() error {
+	if v, ok := rtuo.mutation.Namespace(); ok {
+		if err := relationtuple.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.namespace": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.ObjectID(); ok {
+		if err := relationtuple.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.object_id": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.Relation(); ok {
+		if err := relationtuple.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.relation": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.SubjectNamespace(); ok {
+		if err := relationtuple.SubjectNamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "subject_namespace", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_namespace": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.SubjectID(); ok {
+		if err := relationtuple.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuple.subject_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rtuo *RelationTupleUpdateOne) sqlSave(ctx context.Context) (_node *RelationTuple, err error) {
+	if err := rtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   relationtuple.Table,
+			Columns: relationtuple.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt64,
+				Column: relationtuple.FieldID,
+			},
+		},
+	}
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RelationTuple.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, relationtuple.FieldID)
+		for _, f := range fields {
+			if !relationtuple.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != relationtuple.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.CreatedBy(); ok {
+		_spec.SetField(relationtuple.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := rtuo.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(relationtuple.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := rtuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(relationtuple.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := rtuo.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(relationtuple.FieldUpdatedBy, field.TypeInt64, value)
+	}
+	if value, ok := rtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(relationtuple.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rtuo.mutation.DeletedAt(); ok {
+		_spec.SetField(relationtuple.FieldDeletedAt, field.TypeTime, value)
+	}
+	if value, ok := rtuo.mutation.Namespace(); ok {
+		_spec.SetField(relationtuple.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuple.FieldObjectID, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.Relation(); ok {
+		_spec.SetField(relationtuple.FieldRelation, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.SubjectNamespace(); ok {
+		_spec.SetField(relationtuple.FieldSubjectNamespace, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuple.FieldSubjectID, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuple.FieldSubjectRelation, field.TypeString, value)
+	}
+	_node = &RelationTuple{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relationtuple.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
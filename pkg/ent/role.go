@@ -142,19 +142,19 @@ func (r *Role) assignValues(columns []string, values []any) error {
 
 // QueryUsers queries the "users" edge of the Role entity.
 func (r *Role) QueryUsers() *UserQuery {
-	return (&RoleClient{config: r.config}).QueryUsers(r)
+	return NewRoleClient(r.config).QueryUsers(r)
 }
 
 // QueryUserRoles queries the "user_roles" edge of the Role entity.
 func (r *Role) QueryUserRoles() *UserRoleQuery {
-	return (&RoleClient{config: r.config}).QueryUserRoles(r)
+	return NewRoleClient(r.config).QueryUserRoles(r)
 }
 
 // Update returns a builder for updating this Role.
 // Note that you need to call Role.Unwrap() before calling this method if this Role
 // was returned from a transaction, and the transaction was committed or rolled back.
 func (r *Role) Update() *RoleUpdateOne {
-	return (&RoleClient{config: r.config}).UpdateOne(r)
+	return NewRoleClient(r.config).UpdateOne(r)
 }
 
 // Unwrap unwraps the Role entity that was returned from a transaction after it was closed,
@@ -4,7 +4,6 @@ package ent
 
 import (
 	"context"
-	"fmt"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -28,34 +27,7 @@ func (rd *RoleDelete) Where(ps ...predicate.Role) *RoleDelete {
 
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (rd *RoleDelete) Exec(ctx context.Context) (int, error) {
-	var (
-		err      error
-		affected int
-	)
-	if len(rd.hooks) == 0 {
-		affected, err = rd.sqlExec(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*RoleMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			rd.mutation = mutation
-			affected, err = rd.sqlExec(ctx)
-			mutation.done = true
-			return affected, err
-		})
-		for i := len(rd.hooks) - 1; i >= 0; i-- {
-			if rd.hooks[i] == nil {
-				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = rd.hooks[i](mut)
-		}
-		if _, err := mut.Mutate(ctx, rd.mutation); err != nil {
-			return 0, err
-		}
-	}
-	return affected, err
+	return withHooks[int, RoleMutation](ctx, rd.sqlExec, rd.mutation, rd.hooks)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
@@ -88,6 +60,7 @@ func (rd *RoleDelete) sqlExec(ctx context.Context) (int, error) {
 	if err != nil && sqlgraph.IsConstraintError(err) {
 		err = &ConstraintError{msg: err.Error(), wrap: err}
 	}
+	rd.mutation.done = true
 	return affected, err
 }
 
@@ -2,9 +2,436 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/stark-sim/cas/pkg/ent/runtime.go
+import (
+	"time"
+
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
+	"github.com/stark-sim/cas/pkg/ent/auditevent"
+	"github.com/stark-sim/cas/pkg/ent/outboxevent"
+	"github.com/stark-sim/cas/pkg/ent/relationtuple"
+	"github.com/stark-sim/cas/pkg/ent/role"
+	"github.com/stark-sim/cas/pkg/ent/schema"
+	"github.com/stark-sim/cas/pkg/ent/user"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/pkg/ent/webhookdelivery"
+	"github.com/stark-sim/cas/pkg/ent/webhooksubscription"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	accesspolicyMixin := schema.AccessPolicy{}.Mixin()
+	accesspolicyMixinHooks0 := accesspolicyMixin[0].Hooks()
+	accesspolicyMixinHooks1 := accesspolicyMixin[1].Hooks()
+	accesspolicy.Hooks[0] = accesspolicyMixinHooks0[0]
+	accesspolicy.Hooks[1] = accesspolicyMixinHooks1[0]
+	accesspolicyMixinInters1 := accesspolicyMixin[1].Interceptors()
+	accesspolicy.Interceptors[0] = accesspolicyMixinInters1[0]
+	accesspolicyMixinFields0 := accesspolicyMixin[0].Fields()
+	_ = accesspolicyMixinFields0
+	accesspolicyMixinFields1 := accesspolicyMixin[1].Fields()
+	_ = accesspolicyMixinFields1
+	accesspolicyFields := schema.AccessPolicy{}.Fields()
+	_ = accesspolicyFields
+	// accesspolicyDescCreatedBy is the schema descriptor for created_by field.
+	accesspolicyDescCreatedBy := accesspolicyMixinFields0[1].Descriptor()
+	// accesspolicy.DefaultCreatedBy holds the default value on creation for the created_by field.
+	accesspolicy.DefaultCreatedBy = accesspolicyDescCreatedBy.Default.(int64)
+	// accesspolicyDescUpdatedBy is the schema descriptor for updated_by field.
+	accesspolicyDescUpdatedBy := accesspolicyMixinFields0[2].Descriptor()
+	// accesspolicy.DefaultUpdatedBy holds the default value on creation for the updated_by field.
+	accesspolicy.DefaultUpdatedBy = accesspolicyDescUpdatedBy.Default.(int64)
+	// accesspolicyDescCreatedAt is the schema descriptor for created_at field.
+	accesspolicyDescCreatedAt := accesspolicyMixinFields0[3].Descriptor()
+	// accesspolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesspolicy.DefaultCreatedAt = accesspolicyDescCreatedAt.Default.(func() time.Time)
+	// accesspolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	accesspolicyDescUpdatedAt := accesspolicyMixinFields0[4].Descriptor()
+	// accesspolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accesspolicy.DefaultUpdatedAt = accesspolicyDescUpdatedAt.Default.(func() time.Time)
+	// accesspolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accesspolicy.UpdateDefaultUpdatedAt = accesspolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accesspolicyDescDeletedAt is the schema descriptor for deleted_at field.
+	accesspolicyDescDeletedAt := accesspolicyMixinFields1[0].Descriptor()
+	// accesspolicy.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	accesspolicy.DefaultDeletedAt = accesspolicyDescDeletedAt.Default.(time.Time)
+	// accesspolicyDescName is the schema descriptor for name field.
+	accesspolicyDescName := accesspolicyFields[0].Descriptor()
+	// accesspolicy.DefaultName holds the default value on creation for the name field.
+	accesspolicy.DefaultName = accesspolicyDescName.Default.(string)
+	// accesspolicyDescDescription is the schema descriptor for description field.
+	accesspolicyDescDescription := accesspolicyFields[1].Descriptor()
+	// accesspolicy.DefaultDescription holds the default value on creation for the description field.
+	accesspolicy.DefaultDescription = accesspolicyDescDescription.Default.(string)
+	// accesspolicyDescAction is the schema descriptor for action field.
+	accesspolicyDescAction := accesspolicyFields[3].Descriptor()
+	// accesspolicy.DefaultAction holds the default value on creation for the action field.
+	accesspolicy.DefaultAction = accesspolicyDescAction.Default.(string)
+	// accesspolicyDescResource is the schema descriptor for resource field.
+	accesspolicyDescResource := accesspolicyFields[4].Descriptor()
+	// accesspolicy.DefaultResource holds the default value on creation for the resource field.
+	accesspolicy.DefaultResource = accesspolicyDescResource.Default.(string)
+	// accesspolicyDescCondition is the schema descriptor for condition field.
+	accesspolicyDescCondition := accesspolicyFields[5].Descriptor()
+	// accesspolicy.DefaultCondition holds the default value on creation for the condition field.
+	accesspolicy.DefaultCondition = accesspolicyDescCondition.Default.(string)
+	// accesspolicy.ConditionValidator is a validator for the "condition" field. It is called by the builders before save.
+	accesspolicy.ConditionValidator = accesspolicyDescCondition.Validators[0].(func(string) error)
+	// accesspolicyDescEnabled is the schema descriptor for enabled field.
+	accesspolicyDescEnabled := accesspolicyFields[6].Descriptor()
+	// accesspolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	accesspolicy.DefaultEnabled = accesspolicyDescEnabled.Default.(bool)
+	// accesspolicyDescID is the schema descriptor for id field.
+	accesspolicyDescID := accesspolicyMixinFields0[0].Descriptor()
+	// accesspolicy.DefaultID holds the default value on creation for the id field.
+	accesspolicy.DefaultID = accesspolicyDescID.Default.(func() int64)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[1].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescActorID is the schema descriptor for actor_id field.
+	auditeventDescActorID := auditeventFields[2].Descriptor()
+	// auditevent.DefaultActorID holds the default value on creation for the actor_id field.
+	auditevent.DefaultActorID = auditeventDescActorID.Default.(int64)
+	// auditeventDescClientIP is the schema descriptor for client_ip field.
+	auditeventDescClientIP := auditeventFields[7].Descriptor()
+	// auditevent.DefaultClientIP holds the default value on creation for the client_ip field.
+	auditevent.DefaultClientIP = auditeventDescClientIP.Default.(string)
+	// auditeventDescRequestID is the schema descriptor for request_id field.
+	auditeventDescRequestID := auditeventFields[8].Descriptor()
+	// auditevent.DefaultRequestID holds the default value on creation for the request_id field.
+	auditevent.DefaultRequestID = auditeventDescRequestID.Default.(string)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() int64)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[1].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescPublishedAt is the schema descriptor for published_at field.
+	outboxeventDescPublishedAt := outboxeventFields[6].Descriptor()
+	// outboxevent.DefaultPublishedAt holds the default value on creation for the published_at field.
+	outboxevent.DefaultPublishedAt = outboxeventDescPublishedAt.Default.(time.Time)
+	// outboxeventDescClaimedUntil is the schema descriptor for claimed_until field.
+	outboxeventDescClaimedUntil := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultClaimedUntil holds the default value on creation for the claimed_until field.
+	outboxevent.DefaultClaimedUntil = outboxeventDescClaimedUntil.Default.(time.Time)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[8].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescLastError is the schema descriptor for last_error field.
+	outboxeventDescLastError := outboxeventFields[9].Descriptor()
+	// outboxevent.DefaultLastError holds the default value on creation for the last_error field.
+	outboxevent.DefaultLastError = outboxeventDescLastError.Default.(string)
+	// outboxeventDescDeadAt is the schema descriptor for dead_at field.
+	outboxeventDescDeadAt := outboxeventFields[10].Descriptor()
+	// outboxevent.DefaultDeadAt holds the default value on creation for the dead_at field.
+	outboxevent.DefaultDeadAt = outboxeventDescDeadAt.Default.(time.Time)
+	// outboxeventDescID is the schema descriptor for id field.
+	outboxeventDescID := outboxeventFields[0].Descriptor()
+	// outboxevent.DefaultID holds the default value on creation for the id field.
+	outboxevent.DefaultID = outboxeventDescID.Default.(func() int64)
+	relationtupleMixin := schema.RelationTuple{}.Mixin()
+	relationtupleMixinHooks0 := relationtupleMixin[0].Hooks()
+	relationtupleMixinHooks1 := relationtupleMixin[1].Hooks()
+	relationtuple.Hooks[0] = relationtupleMixinHooks0[0]
+	relationtuple.Hooks[1] = relationtupleMixinHooks1[0]
+	relationtupleMixinInters1 := relationtupleMixin[1].Interceptors()
+	relationtuple.Interceptors[0] = relationtupleMixinInters1[0]
+	relationtupleMixinFields0 := relationtupleMixin[0].Fields()
+	_ = relationtupleMixinFields0
+	relationtupleMixinFields1 := relationtupleMixin[1].Fields()
+	_ = relationtupleMixinFields1
+	relationtupleFields := schema.RelationTuple{}.Fields()
+	_ = relationtupleFields
+	// relationtupleDescCreatedBy is the schema descriptor for created_by field.
+	relationtupleDescCreatedBy := relationtupleMixinFields0[1].Descriptor()
+	// relationtuple.DefaultCreatedBy holds the default value on creation for the created_by field.
+	relationtuple.DefaultCreatedBy = relationtupleDescCreatedBy.Default.(int64)
+	// relationtupleDescUpdatedBy is the schema descriptor for updated_by field.
+	relationtupleDescUpdatedBy := relationtupleMixinFields0[2].Descriptor()
+	// relationtuple.DefaultUpdatedBy holds the default value on creation for the updated_by field.
+	relationtuple.DefaultUpdatedBy = relationtupleDescUpdatedBy.Default.(int64)
+	// relationtupleDescCreatedAt is the schema descriptor for created_at field.
+	relationtupleDescCreatedAt := relationtupleMixinFields0[3].Descriptor()
+	// relationtuple.DefaultCreatedAt holds the default value on creation for the created_at field.
+	relationtuple.DefaultCreatedAt = relationtupleDescCreatedAt.Default.(func() time.Time)
+	// relationtupleDescUpdatedAt is the schema descriptor for updated_at field.
+	relationtupleDescUpdatedAt := relationtupleMixinFields0[4].Descriptor()
+	// relationtuple.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	relationtuple.DefaultUpdatedAt = relationtupleDescUpdatedAt.Default.(func() time.Time)
+	// relationtuple.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	relationtuple.UpdateDefaultUpdatedAt = relationtupleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// relationtupleDescDeletedAt is the schema descriptor for deleted_at field.
+	relationtupleDescDeletedAt := relationtupleMixinFields1[0].Descriptor()
+	// relationtuple.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	relationtuple.DefaultDeletedAt = relationtupleDescDeletedAt.Default.(time.Time)
+	// relationtupleDescNamespace is the schema descriptor for namespace field.
+	relationtupleDescNamespace := relationtupleFields[0].Descriptor()
+	// relationtuple.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	relationtuple.NamespaceValidator = relationtupleDescNamespace.Validators[0].(func(string) error)
+	// relationtupleDescObjectID is the schema descriptor for object_id field.
+	relationtupleDescObjectID := relationtupleFields[1].Descriptor()
+	// relationtuple.ObjectIDValidator is a validator for the "object_id" field. It is called by the builders before save.
+	relationtuple.ObjectIDValidator = relationtupleDescObjectID.Validators[0].(func(string) error)
+	// relationtupleDescRelation is the schema descriptor for relation field.
+	relationtupleDescRelation := relationtupleFields[2].Descriptor()
+	// relationtuple.RelationValidator is a validator for the "relation" field. It is called by the builders before save.
+	relationtuple.RelationValidator = relationtupleDescRelation.Validators[0].(func(string) error)
+	// relationtupleDescSubjectNamespace is the schema descriptor for subject_namespace field.
+	relationtupleDescSubjectNamespace := relationtupleFields[3].Descriptor()
+	// relationtuple.SubjectNamespaceValidator is a validator for the "subject_namespace" field. It is called by the builders before save.
+	relationtuple.SubjectNamespaceValidator = relationtupleDescSubjectNamespace.Validators[0].(func(string) error)
+	// relationtupleDescSubjectID is the schema descriptor for subject_id field.
+	relationtupleDescSubjectID := relationtupleFields[4].Descriptor()
+	// relationtuple.SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	relationtuple.SubjectIDValidator = relationtupleDescSubjectID.Validators[0].(func(string) error)
+	// relationtupleDescSubjectRelation is the schema descriptor for subject_relation field.
+	relationtupleDescSubjectRelation := relationtupleFields[5].Descriptor()
+	// relationtuple.DefaultSubjectRelation holds the default value on creation for the subject_relation field.
+	relationtuple.DefaultSubjectRelation = relationtupleDescSubjectRelation.Default.(string)
+	// relationtupleDescID is the schema descriptor for id field.
+	relationtupleDescID := relationtupleMixinFields0[0].Descriptor()
+	// relationtuple.DefaultID holds the default value on creation for the id field.
+	relationtuple.DefaultID = relationtupleDescID.Default.(func() int64)
+	roleMixin := schema.Role{}.Mixin()
+	roleMixinHooks0 := roleMixin[0].Hooks()
+	roleMixinHooks1 := roleMixin[1].Hooks()
+	roleHooks := schema.Role{}.Hooks()
+	role.Hooks[0] = roleMixinHooks0[0]
+	role.Hooks[1] = roleMixinHooks1[0]
+	role.Hooks[2] = roleHooks[0]
+	roleMixinInters1 := roleMixin[1].Interceptors()
+	role.Interceptors[0] = roleMixinInters1[0]
+	roleMixinFields0 := roleMixin[0].Fields()
+	_ = roleMixinFields0
+	roleMixinFields1 := roleMixin[1].Fields()
+	_ = roleMixinFields1
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescCreatedBy is the schema descriptor for created_by field.
+	roleDescCreatedBy := roleMixinFields0[1].Descriptor()
+	// role.DefaultCreatedBy holds the default value on creation for the created_by field.
+	role.DefaultCreatedBy = roleDescCreatedBy.Default.(int64)
+	// roleDescUpdatedBy is the schema descriptor for updated_by field.
+	roleDescUpdatedBy := roleMixinFields0[2].Descriptor()
+	// role.DefaultUpdatedBy holds the default value on creation for the updated_by field.
+	role.DefaultUpdatedBy = roleDescUpdatedBy.Default.(int64)
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleMixinFields0[3].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleMixinFields0[4].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// roleDescDeletedAt is the schema descriptor for deleted_at field.
+	roleDescDeletedAt := roleMixinFields1[0].Descriptor()
+	// role.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	role.DefaultDeletedAt = roleDescDeletedAt.Default.(time.Time)
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[0].Descriptor()
+	// role.DefaultName holds the default value on creation for the name field.
+	role.DefaultName = roleDescName.Default.(string)
+	// roleDescID is the schema descriptor for id field.
+	roleDescID := roleMixinFields0[0].Descriptor()
+	// role.DefaultID holds the default value on creation for the id field.
+	role.DefaultID = roleDescID.Default.(func() int64)
+	userMixin := schema.User{}.Mixin()
+	userMixinHooks0 := userMixin[0].Hooks()
+	userMixinHooks1 := userMixin[1].Hooks()
+	userHooks := schema.User{}.Hooks()
+	user.Hooks[0] = userMixinHooks0[0]
+	user.Hooks[1] = userMixinHooks1[0]
+	user.Hooks[2] = userHooks[0]
+	userMixinInters1 := userMixin[1].Interceptors()
+	user.Interceptors[0] = userMixinInters1[0]
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userMixinFields1 := userMixin[1].Fields()
+	_ = userMixinFields1
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreatedBy is the schema descriptor for created_by field.
+	userDescCreatedBy := userMixinFields0[1].Descriptor()
+	// user.DefaultCreatedBy holds the default value on creation for the created_by field.
+	user.DefaultCreatedBy = userDescCreatedBy.Default.(int64)
+	// userDescUpdatedBy is the schema descriptor for updated_by field.
+	userDescUpdatedBy := userMixinFields0[2].Descriptor()
+	// user.DefaultUpdatedBy holds the default value on creation for the updated_by field.
+	user.DefaultUpdatedBy = userDescUpdatedBy.Default.(int64)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userMixinFields0[3].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userMixinFields0[4].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescDeletedAt is the schema descriptor for deleted_at field.
+	userDescDeletedAt := userMixinFields1[0].Descriptor()
+	// user.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	user.DefaultDeletedAt = userDescDeletedAt.Default.(time.Time)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[0].Descriptor()
+	// user.DefaultName holds the default value on creation for the name field.
+	user.DefaultName = userDescName.Default.(string)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userMixinFields0[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() int64)
+	userroleMixin := schema.UserRole{}.Mixin()
+	userroleMixinHooks0 := userroleMixin[0].Hooks()
+	userroleMixinHooks1 := userroleMixin[1].Hooks()
+	userrole.Hooks[0] = userroleMixinHooks0[0]
+	userrole.Hooks[1] = userroleMixinHooks1[0]
+	userroleMixinInters1 := userroleMixin[1].Interceptors()
+	userrole.Interceptors[0] = userroleMixinInters1[0]
+	userroleMixinFields0 := userroleMixin[0].Fields()
+	_ = userroleMixinFields0
+	userroleMixinFields1 := userroleMixin[1].Fields()
+	_ = userroleMixinFields1
+	userroleFields := schema.UserRole{}.Fields()
+	_ = userroleFields
+	// userroleDescCreatedBy is the schema descriptor for created_by field.
+	userroleDescCreatedBy := userroleMixinFields0[1].Descriptor()
+	// userrole.DefaultCreatedBy holds the default value on creation for the created_by field.
+	userrole.DefaultCreatedBy = userroleDescCreatedBy.Default.(int64)
+	// userroleDescUpdatedBy is the schema descriptor for updated_by field.
+	userroleDescUpdatedBy := userroleMixinFields0[2].Descriptor()
+	// userrole.DefaultUpdatedBy holds the default value on creation for the updated_by field.
+	userrole.DefaultUpdatedBy = userroleDescUpdatedBy.Default.(int64)
+	// userroleDescCreatedAt is the schema descriptor for created_at field.
+	userroleDescCreatedAt := userroleMixinFields0[3].Descriptor()
+	// userrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userrole.DefaultCreatedAt = userroleDescCreatedAt.Default.(func() time.Time)
+	// userroleDescUpdatedAt is the schema descriptor for updated_at field.
+	userroleDescUpdatedAt := userroleMixinFields0[4].Descriptor()
+	// userrole.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userrole.DefaultUpdatedAt = userroleDescUpdatedAt.Default.(func() time.Time)
+	// userrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userrole.UpdateDefaultUpdatedAt = userroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userroleDescDeletedAt is the schema descriptor for deleted_at field.
+	userroleDescDeletedAt := userroleMixinFields1[0].Descriptor()
+	// userrole.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	userrole.DefaultDeletedAt = userroleDescDeletedAt.Default.(time.Time)
+	// userroleDescValidFrom is the schema descriptor for valid_from field.
+	userroleDescValidFrom := userroleFields[2].Descriptor()
+	// userrole.DefaultValidFrom holds the default value on creation for the valid_from field.
+	userrole.DefaultValidFrom = userroleDescValidFrom.Default.(time.Time)
+	// userroleDescValidUntil is the schema descriptor for valid_until field.
+	userroleDescValidUntil := userroleFields[3].Descriptor()
+	// userrole.DefaultValidUntil holds the default value on creation for the valid_until field.
+	userrole.DefaultValidUntil = userroleDescValidUntil.Default.(time.Time)
+	// userroleDescID is the schema descriptor for id field.
+	userroleDescID := userroleMixinFields0[0].Descriptor()
+	// userrole.DefaultID holds the default value on creation for the id field.
+	userrole.DefaultID = userroleDescID.Default.(func() int64)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescResponseStatus is the schema descriptor for response_status field.
+	webhookdeliveryDescResponseStatus := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultResponseStatus holds the default value on creation for the response_status field.
+	webhookdelivery.DefaultResponseStatus = webhookdeliveryDescResponseStatus.Default.(int)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultLastError holds the default value on creation for the last_error field.
+	webhookdelivery.DefaultLastError = webhookdeliveryDescLastError.Default.(string)
+	// webhookdeliveryDescDeliveredAt is the schema descriptor for delivered_at field.
+	webhookdeliveryDescDeliveredAt := webhookdeliveryFields[12].Descriptor()
+	// webhookdelivery.DefaultDeliveredAt holds the default value on creation for the delivered_at field.
+	webhookdelivery.DefaultDeliveredAt = webhookdeliveryDescDeliveredAt.Default.(time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() int64)
+	webhooksubscriptionMixin := schema.WebhookSubscription{}.Mixin()
+	webhooksubscriptionMixinHooks0 := webhooksubscriptionMixin[0].Hooks()
+	webhooksubscriptionMixinHooks1 := webhooksubscriptionMixin[1].Hooks()
+	webhooksubscription.Hooks[0] = webhooksubscriptionMixinHooks0[0]
+	webhooksubscription.Hooks[1] = webhooksubscriptionMixinHooks1[0]
+	webhooksubscriptionMixinInters1 := webhooksubscriptionMixin[1].Interceptors()
+	webhooksubscription.Interceptors[0] = webhooksubscriptionMixinInters1[0]
+	webhooksubscriptionMixinFields0 := webhooksubscriptionMixin[0].Fields()
+	_ = webhooksubscriptionMixinFields0
+	webhooksubscriptionMixinFields1 := webhooksubscriptionMixin[1].Fields()
+	_ = webhooksubscriptionMixinFields1
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescCreatedBy is the schema descriptor for created_by field.
+	webhooksubscriptionDescCreatedBy := webhooksubscriptionMixinFields0[1].Descriptor()
+	// webhooksubscription.DefaultCreatedBy holds the default value on creation for the created_by field.
+	webhooksubscription.DefaultCreatedBy = webhooksubscriptionDescCreatedBy.Default.(int64)
+	// webhooksubscriptionDescUpdatedBy is the schema descriptor for updated_by field.
+	webhooksubscriptionDescUpdatedBy := webhooksubscriptionMixinFields0[2].Descriptor()
+	// webhooksubscription.DefaultUpdatedBy holds the default value on creation for the updated_by field.
+	webhooksubscription.DefaultUpdatedBy = webhooksubscriptionDescUpdatedBy.Default.(int64)
+	// webhooksubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	webhooksubscriptionDescCreatedAt := webhooksubscriptionMixinFields0[3].Descriptor()
+	// webhooksubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhooksubscription.DefaultCreatedAt = webhooksubscriptionDescCreatedAt.Default.(func() time.Time)
+	// webhooksubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	webhooksubscriptionDescUpdatedAt := webhooksubscriptionMixinFields0[4].Descriptor()
+	// webhooksubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhooksubscription.DefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// webhooksubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhooksubscription.UpdateDefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhooksubscriptionDescDeletedAt is the schema descriptor for deleted_at field.
+	webhooksubscriptionDescDeletedAt := webhooksubscriptionMixinFields1[0].Descriptor()
+	// webhooksubscription.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	webhooksubscription.DefaultDeletedAt = webhooksubscriptionDescDeletedAt.Default.(time.Time)
+	// webhooksubscriptionDescName is the schema descriptor for name field.
+	webhooksubscriptionDescName := webhooksubscriptionFields[0].Descriptor()
+	// webhooksubscription.DefaultName holds the default value on creation for the name field.
+	webhooksubscription.DefaultName = webhooksubscriptionDescName.Default.(string)
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[1].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = webhooksubscriptionDescURL.Validators[0].(func(string) error)
+	// webhooksubscriptionDescSecret is the schema descriptor for secret field.
+	webhooksubscriptionDescSecret := webhooksubscriptionFields[3].Descriptor()
+	// webhooksubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooksubscription.SecretValidator = webhooksubscriptionDescSecret.Validators[0].(func(string) error)
+	// webhooksubscriptionDescEnabled is the schema descriptor for enabled field.
+	webhooksubscriptionDescEnabled := webhooksubscriptionFields[4].Descriptor()
+	// webhooksubscription.DefaultEnabled holds the default value on creation for the enabled field.
+	webhooksubscription.DefaultEnabled = webhooksubscriptionDescEnabled.Default.(bool)
+	// webhooksubscriptionDescID is the schema descriptor for id field.
+	webhooksubscriptionDescID := webhooksubscriptionMixinFields0[0].Descriptor()
+	// webhooksubscription.DefaultID holds the default value on creation for the id field.
+	webhooksubscription.DefaultID = webhooksubscriptionDescID.Default.(func() int64)
+}
 
 const (
-	Version = "v0.11.5-0.20221031135557-521f9b57bc3d"           // Version of ent codegen.
-	Sum     = "h1:slfuvOSWSiV0ldHU+V+O2cfOEgWRpbRloUeE9k7wXnw=" // Sum of ent codegen.
+	Version = "v0.11.6"                                         // Version of ent codegen.
+	Sum     = "h1:fMQwhuzbPv12AXdrAGyHoOcgh9r0D9F8WEsCRoUWxVc=" // Sum of ent codegen.
 )
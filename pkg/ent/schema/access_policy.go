@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/stark-sim/cas/pkg/abac/condition"
+)
+
+// AccessPolicy holds the schema definition for the AccessPolicy entity.
+// 基于属性的访问策略，condition 为 CEL 表达式
+type AccessPolicy struct {
+	ent.Schema
+}
+
+// Fields of the AccessPolicy.
+func (AccessPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Default("").Annotations(entgql.OrderField("NAME"), entproto.Field(11)),
+		field.String("description").Default("").Annotations(entproto.Field(12)),
+		field.Enum("effect").
+			NamedValues("Allow", "ALLOW", "Deny", "DENY").
+			Default("ALLOW").
+			Annotations(entproto.Field(13), entproto.Enum(map[string]int32{"ALLOW": 1, "DENY": 2})),
+		// * 表示任意动作
+		field.String("action").Default("*").Annotations(entgql.OrderField("ACTION"), entproto.Field(14)),
+		// * 表示任意资源类型
+		field.String("resource").Default("*").Annotations(entgql.OrderField("RESOURCE"), entproto.Field(15)),
+		// 保存时即编译校验
+		field.Text("condition").Default("true").Validate(condition.Validate).Annotations(entproto.Field(16)),
+		field.Bool("enabled").Default(true).Annotations(entproto.Field(17)),
+	}
+}
+
+func (AccessPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
+func (AccessPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource", "action"),
+	}
+}
+
+func (AccessPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+		entproto.Message(),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/stark-sim/cas/tools"
+	"time"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+// 审计日志只增不改，所以不使用 BaseMixin
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	falsePtr := false
+	return []ent.Field{
+		field.Int64("id").
+			Unique().
+			Immutable().
+			Annotations(entsql.Annotation{Incremental: &falsePtr}).
+			DefaultFunc(func() int64 {
+				return tools.GenSnowflakeID()
+			}),
+		field.Time("created_at").Immutable().Default(time.Now).StructTag(`json:"created_at"`).Annotations(entgql.OrderField("CREATED_AT")),
+		// 操作人，0 为匿名或系统
+		field.Int64("actor_id").Immutable().Default(0).Annotations(entgql.Type("String")),
+		field.Enum("operation").
+			NamedValues("Create", "CREATE", "Update", "UPDATE", "Delete", "DELETE", "Purge", "PURGE").
+			Immutable(),
+		field.String("entity_type").Immutable().Annotations(entgql.OrderField("ENTITY_TYPE")),
+		field.Int64("entity_id").Immutable().Annotations(entgql.Type("String")),
+		// 字段名 -> {before, after}，边为 {added, removed}
+		field.JSON("changes", map[string]interface{}{}).Immutable().Optional().Annotations(entgql.Type("Map")),
+		field.String("client_ip").Immutable().Default(""),
+		field.String("request_id").Immutable().Default(""),
+	}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("actor_id"),
+		index.Fields("created_at"),
+	}
+}
+
+func (AuditEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField(),
+	}
+}
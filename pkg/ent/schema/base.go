@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"context"
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent/dialect/entsql"
 	"github.com/stark-sim/cas/tools"
@@ -27,10 +28,36 @@ func (BaseMixin) Fields() []ent.Field {
 				return tools.GenSnowflakeID()
 			}),
 		//field.Int64("id").Immutable().DefaultFunc(tools.GenSnowflakeID()),
-		field.Int64("created_by").Default(0).StructTag(`json:"created_by"`).Annotations(entgql.Type("String"), entproto.Field(2)),
-		field.Int64("updated_by").Default(0).StructTag(`json:"updated_by"`).Annotations(entgql.Type("String"), entproto.Field(3)),
+		// 由 Hooks 根据 ctx 中的操作人填写，不允许调用方传入
+		field.Int64("created_by").Default(0).StructTag(`json:"created_by"`).Annotations(entgql.Type("String"), entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput), entproto.Field(2)),
+		field.Int64("updated_by").Default(0).StructTag(`json:"updated_by"`).Annotations(entgql.Type("String"), entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput), entproto.Field(3)),
 		field.Time("created_at").Immutable().Default(time.Now).StructTag(`json:"created_at"`).Annotations(entgql.OrderField("CREATED_AT"), entproto.Field(4)),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).StructTag(`json:"updated_at"`).Annotations(entgql.OrderField("UPDATED_AT"), entproto.Field(5)),
-		field.Time("deleted_at").Default(tools.ZeroTime).StructTag(`json:"deleted_at"`).Annotations(entgql.OrderField("DELETED_AT"), entproto.Field(6)),
+	}
+}
+
+/*
+Hooks 用 ctx 中的操作人覆盖 created_by 与 updated_by，未登录时为 0，系统操作为 tools.SystemUserID
+*/
+func (BaseMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				// 物理删除不涉及
+				if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					return next.Mutate(ctx, m)
+				}
+				userID := tools.GetUserID(ctx)
+				if m.Op().Is(ent.OpCreate) {
+					if err := m.SetField("created_by", userID); err != nil {
+						return nil, err
+					}
+				}
+				if err := m.SetField("updated_by", userID); err != nil {
+					return nil, err
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
 	}
 }
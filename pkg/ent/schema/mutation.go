@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"context"
+	"entgo.io/ent"
+	"fmt"
+	gen "github.com/stark-sim/cas/pkg/ent"
+)
+
+// Mutation 生成的 XxxMutation 都实现了这些方法
+type Mutation interface {
+	ent.Mutation
+	Client() *gen.Client
+	ID() (int64, bool)
+	IDs(ctx context.Context) ([]int64, error)
+}
+
+/*
+RecordFunc 在变更执行前调用，可在此读取旧值，返回 nil 时不记录
+返回的函数在变更执行后以受影响的 ID 调用，与变更在同一事务中执行，返回错误时变更一并失败
+*/
+type RecordFunc func(ctx context.Context, m Mutation) func(ctx context.Context, ids []int64) error
+
+/*
+RecordHook 审计日志、outbox 事件等随变更写入的记录共用的 hook
+软删除会被转换为更新重新执行，届时再记录；受影响的 ID 在执行前取出，执行后条件可能不再命中，恢复操作针对的是已删除的行
+*/
+func RecordHook(record RecordFunc) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mv, ok := m.(Mutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if m.Op().Is(ent.OpDelete|ent.OpDeleteOne) && !SoftDeleteSkipped(ctx) {
+				return next.Mutate(ctx, m)
+			}
+			var ids []int64
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = mv.IDs(SkipSoftDelete(ctx)); err != nil {
+					return nil, err
+				}
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+			}
+			after := record(ctx, mv)
+			if after == nil {
+				return next.Mutate(ctx, m)
+			}
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			if m.Op().Is(ent.OpCreate) {
+				id, _ := mv.ID()
+				ids = []int64{id}
+			}
+			if err = after(ctx, ids); err != nil {
+				return nil, err
+			}
+			return value, nil
+		})
+	}
+}
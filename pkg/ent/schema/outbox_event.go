@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"encoding/json"
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/stark-sim/cas/tools"
+	"time"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+// 待发布到消息总线的事件，与变更在同一事务中写入，由 outbox 的 relay 发布
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	falsePtr := false
+	return []ent.Field{
+		field.Int64("id").
+			Unique().
+			Immutable().
+			Annotations(entsql.Annotation{Incremental: &falsePtr}).
+			DefaultFunc(func() int64 {
+				return tools.GenSnowflakeID()
+			}),
+		field.Time("created_at").Immutable().Default(time.Now).StructTag(`json:"created_at"`),
+		// 如 user.registered、role.assigned
+		field.String("event_type").Immutable(),
+		// 同一聚合的事件按 id 顺序发布
+		field.String("aggregate_type").Immutable(),
+		field.Int64("aggregate_id").Immutable(),
+		// 保留原始 JSON，避免 int64 精度丢失
+		field.JSON("payload", json.RawMessage{}).Immutable().Optional(),
+		// 零值表示未发布
+		field.Time("published_at").Default(tools.ZeroTime),
+		// 被 relay 认领的截止时间，之前其他 relay 不会发布该聚合的事件，进程退出后到期自动释放
+		field.Time("claimed_until").Default(tools.ZeroTime),
+		field.Int("attempts").Default(0),
+		field.String("last_error").Default(""),
+		// 达到重试次数后转为死信，不再发布，零值表示未转为死信
+		field.Time("dead_at").Default(tools.ZeroTime),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("published_at"),
+		index.Fields("aggregate_type", "aggregate_id"),
+	}
+}
+
+func (OutboxEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// 内部使用，不对外提供
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RelationTuple holds the schema definition for the RelationTuple entity.
+// 关系元组 namespace:object_id#relation@subject，subject 为用户或 userset
+type RelationTuple struct {
+	ent.Schema
+}
+
+// MySQL 的索引长度上限为 3072 字节，唯一索引中的字符串需限制长度
+var (
+	mysqlNameType = map[string]string{dialect.MySQL: "varchar(64)"}
+	mysqlIDType   = map[string]string{dialect.MySQL: "varchar(191)"}
+)
+
+// Fields of the RelationTuple.
+func (RelationTuple) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace").NotEmpty().SchemaType(mysqlNameType).Annotations(entproto.Field(11)),
+		field.String("object_id").NotEmpty().SchemaType(mysqlIDType).Annotations(entproto.Field(12)),
+		field.String("relation").NotEmpty().SchemaType(mysqlNameType).Annotations(entproto.Field(13)),
+		field.String("subject_namespace").NotEmpty().SchemaType(mysqlNameType).Annotations(entproto.Field(14)),
+		field.String("subject_id").NotEmpty().SchemaType(mysqlIDType).Annotations(entproto.Field(15)),
+		// 为空时 subject 为具体对象，否则为 userset
+		field.String("subject_relation").Default("").SchemaType(mysqlNameType).Annotations(entproto.Field(16)),
+	}
+}
+
+func (RelationTuple) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
+func (RelationTuple) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "object_id", "relation", "subject_namespace", "subject_id", "subject_relation", "deleted_at").
+			Unique().
+			StorageKey("relationtuple_tuple_deleted_at"),
+		index.Fields("subject_namespace", "subject_id"),
+	}
+}
+
+func (RelationTuple) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// 只通过 gRPC 提供
+		entgql.Skip(),
+		entproto.Message(),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
 )
 
 // Role holds the schema definition for the Role entity.
@@ -24,19 +25,26 @@ func (Role) Fields() []ent.Field {
 // Edges of the Role.
 func (Role) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("users", User.Type).Ref("roles").Through("user_roles", UserRole.Type).Annotations(entproto.Skip()),
+		edge.From("users", User.Type).Ref("roles").Through("user_roles", UserRole.Type).Annotations(entproto.Skip(), entgql.RelayConnection()),
 	}
 }
 
 func (Role) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		BaseMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
+func (Role) Hooks() []ent.Hook {
+	return []ent.Hook{
+		cascadeUserRoles(userrole.RoleIDIn),
 	}
 }
 
 func (Role) Annotations() []schema.Annotation {
 	return []schema.Annotation{
-		//entgql.RelayConnection(),
+		entgql.RelayConnection(),
 		entgql.QueryField(),
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
 		entproto.Message(),
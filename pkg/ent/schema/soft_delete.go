@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"context"
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"fmt"
+	gen "github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/hook"
+	"github.com/stark-sim/cas/pkg/ent/intercept"
+	"github.com/stark-sim/cas/tools"
+	"time"
+)
+
+/*
+SoftDeleteMixin 软删除：deleted_at 为零值表示未删除
+所有查询（包括边的查询）自动过滤已删除的行，Delete 操作转换为更新 deleted_at
+*/
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").Default(tools.ZeroTime).StructTag(`json:"deleted_at"`).Annotations(entgql.OrderField("DELETED_AT"), entproto.Field(6)),
+	}
+}
+
+type softDeleteKey struct{}
+
+// SkipSoftDelete 管理员视图使用，查询包含已删除的行，Delete 操作为物理删除
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+// SoftDeleteSkipped ctx 是否跳过了软删除
+func SoftDeleteSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(softDeleteKey{}).(bool)
+	return skip
+}
+
+func (d SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if SoftDeleteSkipped(ctx) {
+				return nil
+			}
+			d.P(q)
+			return nil
+		}),
+	}
+}
+
+func (d SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					if SoftDeleteSkipped(ctx) {
+						return next.Mutate(ctx, m)
+					}
+					mx, ok := m.(interface {
+						SetOp(ent.Op)
+						Client() *gen.Client
+						SetDeletedAt(time.Time)
+						WhereP(...func(*sql.Selector))
+					})
+					if !ok {
+						return nil, fmt.Errorf("unexpected mutation type %T", m)
+					}
+					// 已删除的行不再处理，然后以更新的方式重新执行，会再次经过所有 hook
+					d.P(mx)
+					mx.SetOp(ent.OpUpdate)
+					mx.SetDeletedAt(time.Now())
+					return mx.Client().Mutate(ctx, m)
+				})
+			},
+			ent.OpDeleteOne|ent.OpDelete,
+		),
+	}
+}
+
+// P 只保留未删除的行
+func (d SoftDeleteMixin) P(w interface{ WhereP(...func(*sql.Selector)) }) {
+	w.WhereP(sql.FieldEQ(d.Fields()[0].Descriptor().Name, tools.ZeroTime))
+}
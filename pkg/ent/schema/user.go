@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
 )
 
 // User holds the schema definition for the User entity.
@@ -26,13 +27,14 @@ func (User) Fields() []ent.Field {
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("roles", Role.Type).Through("user_roles", UserRole.Type).Annotations(entproto.Skip()),
+		edge.To("roles", Role.Type).Through("user_roles", UserRole.Type).Annotations(entproto.Skip(), entgql.RelayConnection()),
 	}
 }
 
 func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		BaseMixin{},
+		SoftDeleteMixin{},
 	}
 }
 
@@ -42,9 +44,15 @@ func (User) Indexes() []ent.Index {
 	}
 }
 
+func (User) Hooks() []ent.Hook {
+	return []ent.Hook{
+		cascadeUserRoles(userrole.UserIDIn),
+	}
+}
+
 func (User) Annotations() []schema.Annotation {
 	return []schema.Annotation{
-		//entgql.RelayConnection(),
+		entgql.RelayConnection(),
 		entgql.QueryField(),
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
 		entproto.Message(),
@@ -1,11 +1,20 @@
 package schema
 
 import (
+	"context"
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"fmt"
+	gen "github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/hook"
+	"github.com/stark-sim/cas/pkg/ent/predicate"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/tools"
+	"time"
 )
 
 type UserRole struct {
@@ -16,6 +25,9 @@ func (UserRole) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("user_id").Annotations(entproto.Field(11)),
 		field.Int64("role_id").Annotations(entproto.Field(12)),
+		// 授权有效期，零值表示不限
+		field.Time("valid_from").Default(tools.ZeroTime).StructTag(`json:"valid_from"`).Annotations(entgql.OrderField("VALID_FROM"), entproto.Field(13)),
+		field.Time("valid_until").Default(tools.ZeroTime).StructTag(`json:"valid_until"`).Annotations(entgql.OrderField("VALID_UNTIL"), entproto.Field(14)),
 	}
 }
 
@@ -29,6 +41,7 @@ func (UserRole) Edges() []ent.Edge {
 func (UserRole) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		BaseMixin{},
+		SoftDeleteMixin{},
 	}
 }
 
@@ -37,3 +50,46 @@ func (UserRole) Annotations() []schema.Annotation {
 		entproto.Message(),
 	}
 }
+
+/*
+cascadeUserRoles 用户或角色被软删除时，在同一事务中软删除其授权
+授权的 deleted_at 与实体相同，恢复实体时据此找回一并删除的授权
+*/
+func cascadeUserRoles(p func(...int64) predicate.UserRole) ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				v, _ := m.Field("deleted_at")
+				deletedAt, _ := v.(time.Time)
+				if deletedAt.IsZero() {
+					return next.Mutate(ctx, m)
+				}
+				mx, ok := m.(interface {
+					Client() *gen.Client
+					IDs(context.Context) ([]int64, error)
+				})
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				// 执行前取出 ID，执行后已删除的行不会再被查到
+				ids, err := mx.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				value, err := next.Mutate(ctx, m)
+				if err != nil || len(ids) == 0 {
+					return value, err
+				}
+				_, err = mx.Client().UserRole.Update().
+					Where(p(ids...), userrole.DeletedAtEQ(tools.ZeroTime)).
+					SetDeletedAt(deletedAt).
+					Save(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return value, nil
+			})
+		},
+		ent.OpUpdate|ent.OpUpdateOne,
+	)
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/stark-sim/cas/tools"
+	"time"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// 投递记录，每个订阅的每个事件一条，记录重试状态
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	falsePtr := false
+	return []ent.Field{
+		field.Int64("id").
+			Unique().
+			Immutable().
+			Annotations(entsql.Annotation{Incremental: &falsePtr}).
+			DefaultFunc(func() int64 {
+				return tools.GenSnowflakeID()
+			}),
+		field.Time("created_at").Immutable().Default(time.Now).StructTag(`json:"created_at"`).Annotations(entgql.OrderField("CREATED_AT")),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).StructTag(`json:"updated_at"`),
+		field.Int64("subscription_id").Immutable(),
+		// outbox 中事件的 ID，接收方据此去重
+		field.Int64("event_id").Immutable().Annotations(entgql.Type("String")),
+		field.String("event_type").Immutable(),
+		// 原样发送并签名的请求体
+		field.Text("body").Immutable(),
+		field.Enum("status").
+			NamedValues("Pending", "PENDING", "Succeeded", "SUCCEEDED", "Failed", "FAILED", "Dead", "DEAD").
+			Default("PENDING").
+			Annotations(entgql.OrderField("STATUS")),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Default(time.Now).Annotations(entgql.OrderField("NEXT_ATTEMPT_AT")),
+		// 最后一次请求的响应码，请求未完成时为 0
+		field.Int("response_status").Default(0),
+		field.String("last_error").Default(""),
+		field.Time("delivered_at").Default(tools.ZeroTime),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", WebhookSubscription.Type).Ref("deliveries").Field("subscription_id").Unique().Required().Immutable(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// 重复发布同一事件时不会重复投递
+		index.Fields("subscription_id", "event_id").Unique(),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField(),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"errors"
+	"net/url"
+)
+
+// WebhookSubscription holds the schema definition for the WebhookSubscription entity.
+// 合作方订阅的回调地址，outbox 中的事件按 event_types 过滤后投递
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+// Fields of the WebhookSubscription.
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Default("").Annotations(entgql.OrderField("NAME")),
+		field.String("url").Validate(validateWebhookURL),
+		// 如 user.registered，可用 user.* 匹配前缀，为空时订阅全部事件
+		field.JSON("event_types", []string{}).Optional(),
+		// 用于 HMAC 签名，只写不读
+		field.String("secret").NotEmpty().Sensitive().Annotations(entgql.Skip(entgql.SkipType, entgql.SkipWhereInput)),
+		field.Bool("enabled").Default(true),
+	}
+}
+
+func (WebhookSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).Annotations(entgql.Skip()),
+	}
+}
+
+func (WebhookSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
+func (WebhookSubscription) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+	}
+}
+
+func validateWebhookURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("webhook url must be an absolute http(s) url")
+	}
+	return nil
+}
@@ -150,19 +150,19 @@ func (u *User) assignValues(columns []string, values []any) error {
 
 // QueryRoles queries the "roles" edge of the User entity.
 func (u *User) QueryRoles() *RoleQuery {
-	return (&UserClient{config: u.config}).QueryRoles(u)
+	return NewUserClient(u.config).QueryRoles(u)
 }
 
 // QueryUserRoles queries the "user_roles" edge of the User entity.
 func (u *User) QueryUserRoles() *UserRoleQuery {
-	return (&UserClient{config: u.config}).QueryUserRoles(u)
+	return NewUserClient(u.config).QueryUserRoles(u)
 }
 
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
 func (u *User) Update() *UserUpdateOne {
-	return (&UserClient{config: u.config}).UpdateOne(u)
+	return NewUserClient(u.config).UpdateOne(u)
 }
 
 // Unwrap unwraps the User entity that was returned from a transaction after it was closed,
@@ -32,6 +32,10 @@ type UserRole struct {
 	UserID int64 `json:"user_id,omitempty"`
 	// RoleID holds the value of the "role_id" field.
 	RoleID int64 `json:"role_id,omitempty"`
+	// ValidFrom holds the value of the "valid_from" field.
+	ValidFrom time.Time `json:"valid_from"`
+	// ValidUntil holds the value of the "valid_until" field.
+	ValidUntil time.Time `json:"valid_until"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserRoleQuery when eager-loading is set.
 	Edges UserRoleEdges `json:"edges"`
@@ -83,7 +87,7 @@ func (*UserRole) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case userrole.FieldID, userrole.FieldCreatedBy, userrole.FieldUpdatedBy, userrole.FieldUserID, userrole.FieldRoleID:
 			values[i] = new(sql.NullInt64)
-		case userrole.FieldCreatedAt, userrole.FieldUpdatedAt, userrole.FieldDeletedAt:
+		case userrole.FieldCreatedAt, userrole.FieldUpdatedAt, userrole.FieldDeletedAt, userrole.FieldValidFrom, userrole.FieldValidUntil:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type UserRole", columns[i])
@@ -148,6 +152,18 @@ func (ur *UserRole) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ur.RoleID = value.Int64
 			}
+		case userrole.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				ur.ValidFrom = value.Time
+			}
+		case userrole.FieldValidUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_until", values[i])
+			} else if value.Valid {
+				ur.ValidUntil = value.Time
+			}
 		}
 	}
 	return nil
@@ -155,19 +171,19 @@ func (ur *UserRole) assignValues(columns []string, values []any) error {
 
 // QueryUser queries the "user" edge of the UserRole entity.
 func (ur *UserRole) QueryUser() *UserQuery {
-	return (&UserRoleClient{config: ur.config}).QueryUser(ur)
+	return NewUserRoleClient(ur.config).QueryUser(ur)
 }
 
 // QueryRole queries the "role" edge of the UserRole entity.
 func (ur *UserRole) QueryRole() *RoleQuery {
-	return (&UserRoleClient{config: ur.config}).QueryRole(ur)
+	return NewUserRoleClient(ur.config).QueryRole(ur)
 }
 
 // Update returns a builder for updating this UserRole.
 // Note that you need to call UserRole.Unwrap() before calling this method if this UserRole
 // was returned from a transaction, and the transaction was committed or rolled back.
 func (ur *UserRole) Update() *UserRoleUpdateOne {
-	return (&UserRoleClient{config: ur.config}).UpdateOne(ur)
+	return NewUserRoleClient(ur.config).UpdateOne(ur)
 }
 
 // Unwrap unwraps the UserRole entity that was returned from a transaction after it was closed,
@@ -206,6 +222,12 @@ func (ur *UserRole) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("role_id=")
 	builder.WriteString(fmt.Sprintf("%v", ur.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("valid_from=")
+	builder.WriteString(ur.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("valid_until=")
+	builder.WriteString(ur.ValidUntil.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,8 @@ package userrole
 
 import (
 	"time"
+
+	"entgo.io/ent"
 )
 
 const (
@@ -25,6 +27,10 @@ const (
 	FieldUserID = "user_id"
 	// FieldRoleID holds the string denoting the role_id field in the database.
 	FieldRoleID = "role_id"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidUntil holds the string denoting the valid_until field in the database.
+	FieldValidUntil = "valid_until"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeRole holds the string denoting the role edge name in mutations.
@@ -57,6 +63,8 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldUserID,
 	FieldRoleID,
+	FieldValidFrom,
+	FieldValidUntil,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -69,7 +77,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/stark-sim/cas/pkg/ent/runtime"
 var (
+	Hooks        [2]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
 	DefaultCreatedBy int64
 	// DefaultUpdatedBy holds the default value on creation for the "updated_by" field.
@@ -82,6 +97,10 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
 	DefaultDeletedAt time.Time
+	// DefaultValidFrom holds the default value on creation for the "valid_from" field.
+	DefaultValidFrom time.Time
+	// DefaultValidUntil holds the default value on creation for the "valid_until" field.
+	DefaultValidUntil time.Time
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() int64
 )
@@ -104,6 +104,34 @@ func (urc *UserRoleCreate) SetRoleID(i int64) *UserRoleCreate {
 	return urc
 }
 
+// SetValidFrom sets the "valid_from" field.
+func (urc *UserRoleCreate) SetValidFrom(t time.Time) *UserRoleCreate {
+	urc.mutation.SetValidFrom(t)
+	return urc
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (urc *UserRoleCreate) SetNillableValidFrom(t *time.Time) *UserRoleCreate {
+	if t != nil {
+		urc.SetValidFrom(*t)
+	}
+	return urc
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (urc *UserRoleCreate) SetValidUntil(t time.Time) *UserRoleCreate {
+	urc.mutation.SetValidUntil(t)
+	return urc
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (urc *UserRoleCreate) SetNillableValidUntil(t *time.Time) *UserRoleCreate {
+	if t != nil {
+		urc.SetValidUntil(*t)
+	}
+	return urc
+}
+
 // SetID sets the "id" field.
 func (urc *UserRoleCreate) SetID(i int64) *UserRoleCreate {
 	urc.mutation.SetID(i)
@@ -135,50 +163,10 @@ func (urc *UserRoleCreate) Mutation() *UserRoleMutation {
 
 // Save creates the UserRole in the database.
 func (urc *UserRoleCreate) Save(ctx context.Context) (*UserRole, error) {
-	var (
-		err  error
-		node *UserRole
-	)
-	urc.defaults()
-	if len(urc.hooks) == 0 {
-		if err = urc.check(); err != nil {
-			return nil, err
-		}
-		node, err = urc.sqlSave(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*UserRoleMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			if err = urc.check(); err != nil {
-				return nil, err
-			}
-			urc.mutation = mutation
-			if node, err = urc.sqlSave(ctx); err != nil {
-				return nil, err
-			}
-			mutation.id = &node.ID
-			mutation.done = true
-			return node, err
-		})
-		for i := len(urc.hooks) - 1; i >= 0; i-- {
-			if urc.hooks[i] == nil {
-				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = urc.hooks[i](mut)
-		}
-		v, err := mut.Mutate(ctx, urc.mutation)
-		if err != nil {
-			return nil, err
-		}
-		nv, ok := v.(*UserRole)
-		if !ok {
-			return nil, fmt.Errorf("unexpected node type %T returned from UserRoleMutation", v)
-		}
-		node = nv
+	if err := urc.defaults(); err != nil {
+		return nil, err
 	}
-	return node, err
+	return withHooks[*UserRole, UserRoleMutation](ctx, urc.sqlSave, urc.mutation, urc.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
@@ -204,7 +192,7 @@ func (urc *UserRoleCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (urc *UserRoleCreate) defaults() {
+func (urc *UserRoleCreate) defaults() error {
 	if _, ok := urc.mutation.CreatedBy(); !ok {
 		v := userrole.DefaultCreatedBy
 		urc.mutation.SetCreatedBy(v)
@@ -214,10 +202,16 @@ func (urc *UserRoleCreate) defaults() {
 		urc.mutation.SetUpdatedBy(v)
 	}
 	if _, ok := urc.mutation.CreatedAt(); !ok {
+		if userrole.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized userrole.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := userrole.DefaultCreatedAt()
 		urc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := urc.mutation.UpdatedAt(); !ok {
+		if userrole.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized userrole.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := userrole.DefaultUpdatedAt()
 		urc.mutation.SetUpdatedAt(v)
 	}
@@ -225,10 +219,22 @@ func (urc *UserRoleCreate) defaults() {
 		v := userrole.DefaultDeletedAt
 		urc.mutation.SetDeletedAt(v)
 	}
+	if _, ok := urc.mutation.ValidFrom(); !ok {
+		v := userrole.DefaultValidFrom
+		urc.mutation.SetValidFrom(v)
+	}
+	if _, ok := urc.mutation.ValidUntil(); !ok {
+		v := userrole.DefaultValidUntil
+		urc.mutation.SetValidUntil(v)
+	}
 	if _, ok := urc.mutation.ID(); !ok {
+		if userrole.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized userrole.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := userrole.DefaultID()
 		urc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -254,6 +260,12 @@ func (urc *UserRoleCreate) check() error {
 	if _, ok := urc.mutation.RoleID(); !ok {
 		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "UserRole.role_id"`)}
 	}
+	if _, ok := urc.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ent: missing required field "UserRole.valid_from"`)}
+	}
+	if _, ok := urc.mutation.ValidUntil(); !ok {
+		return &ValidationError{Name: "valid_until", err: errors.New(`ent: missing required field "UserRole.valid_until"`)}
+	}
 	if _, ok := urc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserRole.user"`)}
 	}
@@ -264,6 +276,9 @@ func (urc *UserRoleCreate) check() error {
 }
 
 func (urc *UserRoleCreate) sqlSave(ctx context.Context) (*UserRole, error) {
+	if err := urc.check(); err != nil {
+		return nil, err
+	}
 	_node, _spec := urc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, urc.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
@@ -275,6 +290,8 @@ func (urc *UserRoleCreate) sqlSave(ctx context.Context) (*UserRole, error) {
 		id := _spec.ID.Value.(int64)
 		_node.ID = int64(id)
 	}
+	urc.mutation.id = &_node.ID
+	urc.mutation.done = true
 	return _node, nil
 }
 
@@ -313,6 +330,14 @@ func (urc *UserRoleCreate) createSpec() (*UserRole, *sqlgraph.CreateSpec) {
 		_spec.SetField(userrole.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
 	}
+	if value, ok := urc.mutation.ValidFrom(); ok {
+		_spec.SetField(userrole.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := urc.mutation.ValidUntil(); ok {
+		_spec.SetField(userrole.FieldValidUntil, field.TypeTime, value)
+		_node.ValidUntil = value
+	}
 	if nodes := urc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
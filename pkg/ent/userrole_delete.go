@@ -4,7 +4,6 @@ package ent
 
 import (
 	"context"
-	"fmt"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -28,34 +27,7 @@ func (urd *UserRoleDelete) Where(ps ...predicate.UserRole) *UserRoleDelete {
 
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (urd *UserRoleDelete) Exec(ctx context.Context) (int, error) {
-	var (
-		err      error
-		affected int
-	)
-	if len(urd.hooks) == 0 {
-		affected, err = urd.sqlExec(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*UserRoleMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			urd.mutation = mutation
-			affected, err = urd.sqlExec(ctx)
-			mutation.done = true
-			return affected, err
-		})
-		for i := len(urd.hooks) - 1; i >= 0; i-- {
-			if urd.hooks[i] == nil {
-				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = urd.hooks[i](mut)
-		}
-		if _, err := mut.Mutate(ctx, urd.mutation); err != nil {
-			return 0, err
-		}
-	}
-	return affected, err
+	return withHooks[int, UserRoleMutation](ctx, urd.sqlExec, urd.mutation, urd.hooks)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
@@ -88,6 +60,7 @@ func (urd *UserRoleDelete) sqlExec(ctx context.Context) (int, error) {
 	if err != nil && sqlgraph.IsConstraintError(err) {
 		err = &ConstraintError{msg: err.Error(), wrap: err}
 	}
+	urd.mutation.done = true
 	return affected, err
 }
 
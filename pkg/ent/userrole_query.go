@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -19,16 +20,14 @@ import (
 // UserRoleQuery is the builder for querying UserRole entities.
 type UserRoleQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
+	ctx        *QueryContext
 	order      []OrderFunc
-	fields     []string
+	inters     []Interceptor
 	predicates []predicate.UserRole
 	withUser   *UserQuery
 	withRole   *RoleQuery
-	modifiers  []func(*sql.Selector)
 	loadTotal  []func(context.Context, []*UserRole) error
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -40,26 +39,26 @@ func (urq *UserRoleQuery) Where(ps ...predicate.UserRole) *UserRoleQuery {
 	return urq
 }
 
-// Limit adds a limit step to the query.
+// Limit the number of records to be returned by this query.
 func (urq *UserRoleQuery) Limit(limit int) *UserRoleQuery {
-	urq.limit = &limit
+	urq.ctx.Limit = &limit
 	return urq
 }
 
-// Offset adds an offset step to the query.
+// Offset to start from.
 func (urq *UserRoleQuery) Offset(offset int) *UserRoleQuery {
-	urq.offset = &offset
+	urq.ctx.Offset = &offset
 	return urq
 }
 
 // Unique configures the query builder to filter duplicate records on query.
 // By default, unique is set to true, and can be disabled using this method.
 func (urq *UserRoleQuery) Unique(unique bool) *UserRoleQuery {
-	urq.unique = &unique
+	urq.ctx.Unique = &unique
 	return urq
 }
 
-// Order adds an order step to the query.
+// Order specifies how the records should be ordered.
 func (urq *UserRoleQuery) Order(o ...OrderFunc) *UserRoleQuery {
 	urq.order = append(urq.order, o...)
 	return urq
@@ -67,7 +66,7 @@ func (urq *UserRoleQuery) Order(o ...OrderFunc) *UserRoleQuery {
 
 // QueryUser chains the current query on the "user" edge.
 func (urq *UserRoleQuery) QueryUser() *UserQuery {
-	query := &UserQuery{config: urq.config}
+	query := (&UserClient{config: urq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := urq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -89,7 +88,7 @@ func (urq *UserRoleQuery) QueryUser() *UserQuery {
 
 // QueryRole chains the current query on the "role" edge.
 func (urq *UserRoleQuery) QueryRole() *RoleQuery {
-	query := &RoleQuery{config: urq.config}
+	query := (&RoleClient{config: urq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := urq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -112,7 +111,7 @@ func (urq *UserRoleQuery) QueryRole() *RoleQuery {
 // First returns the first UserRole entity from the query.
 // Returns a *NotFoundError when no UserRole was found.
 func (urq *UserRoleQuery) First(ctx context.Context) (*UserRole, error) {
-	nodes, err := urq.Limit(1).All(ctx)
+	nodes, err := urq.Limit(1).All(setContextOp(ctx, urq.ctx, "First"))
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +134,7 @@ func (urq *UserRoleQuery) FirstX(ctx context.Context) *UserRole {
 // Returns a *NotFoundError when no UserRole ID was found.
 func (urq *UserRoleQuery) FirstID(ctx context.Context) (id int64, err error) {
 	var ids []int64
-	if ids, err = urq.Limit(1).IDs(ctx); err != nil {
+	if ids, err = urq.Limit(1).IDs(setContextOp(ctx, urq.ctx, "FirstID")); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -158,7 +157,7 @@ func (urq *UserRoleQuery) FirstIDX(ctx context.Context) int64 {
 // Returns a *NotSingularError when more than one UserRole entity is found.
 // Returns a *NotFoundError when no UserRole entities are found.
 func (urq *UserRoleQuery) Only(ctx context.Context) (*UserRole, error) {
-	nodes, err := urq.Limit(2).All(ctx)
+	nodes, err := urq.Limit(2).All(setContextOp(ctx, urq.ctx, "Only"))
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +185,7 @@ func (urq *UserRoleQuery) OnlyX(ctx context.Context) *UserRole {
 // Returns a *NotFoundError when no entities are found.
 func (urq *UserRoleQuery) OnlyID(ctx context.Context) (id int64, err error) {
 	var ids []int64
-	if ids, err = urq.Limit(2).IDs(ctx); err != nil {
+	if ids, err = urq.Limit(2).IDs(setContextOp(ctx, urq.ctx, "OnlyID")); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -211,10 +210,12 @@ func (urq *UserRoleQuery) OnlyIDX(ctx context.Context) int64 {
 
 // All executes the query and returns a list of UserRoles.
 func (urq *UserRoleQuery) All(ctx context.Context) ([]*UserRole, error) {
+	ctx = setContextOp(ctx, urq.ctx, "All")
 	if err := urq.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
-	return urq.sqlAll(ctx)
+	qr := querierAll[[]*UserRole, *UserRoleQuery]()
+	return withInterceptors[[]*UserRole](ctx, urq, qr, urq.inters)
 }
 
 // AllX is like All, but panics if an error occurs.
@@ -229,6 +230,7 @@ func (urq *UserRoleQuery) AllX(ctx context.Context) []*UserRole {
 // IDs executes the query and returns a list of UserRole IDs.
 func (urq *UserRoleQuery) IDs(ctx context.Context) ([]int64, error) {
 	var ids []int64
+	ctx = setContextOp(ctx, urq.ctx, "IDs")
 	if err := urq.Select(userrole.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
@@ -246,10 +248,11 @@ func (urq *UserRoleQuery) IDsX(ctx context.Context) []int64 {
 
 // Count returns the count of the given query.
 func (urq *UserRoleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, urq.ctx, "Count")
 	if err := urq.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return urq.sqlCount(ctx)
+	return withInterceptors[int](ctx, urq, querierCount[*UserRoleQuery](), urq.inters)
 }
 
 // CountX is like Count, but panics if an error occurs.
@@ -263,10 +266,15 @@ func (urq *UserRoleQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (urq *UserRoleQuery) Exist(ctx context.Context) (bool, error) {
-	if err := urq.prepareQuery(ctx); err != nil {
-		return false, err
+	ctx = setContextOp(ctx, urq.ctx, "Exist")
+	switch _, err := urq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
 	}
-	return urq.sqlExist(ctx)
 }
 
 // ExistX is like Exist, but panics if an error occurs.
@@ -286,23 +294,22 @@ func (urq *UserRoleQuery) Clone() *UserRoleQuery {
 	}
 	return &UserRoleQuery{
 		config:     urq.config,
-		limit:      urq.limit,
-		offset:     urq.offset,
+		ctx:        urq.ctx.Clone(),
 		order:      append([]OrderFunc{}, urq.order...),
+		inters:     append([]Interceptor{}, urq.inters...),
 		predicates: append([]predicate.UserRole{}, urq.predicates...),
 		withUser:   urq.withUser.Clone(),
 		withRole:   urq.withRole.Clone(),
 		// clone intermediate query.
-		sql:    urq.sql.Clone(),
-		path:   urq.path,
-		unique: urq.unique,
+		sql:  urq.sql.Clone(),
+		path: urq.path,
 	}
 }
 
 // WithUser tells the query-builder to eager-load the nodes that are connected to
 // the "user" edge. The optional arguments are used to configure the query builder of the edge.
 func (urq *UserRoleQuery) WithUser(opts ...func(*UserQuery)) *UserRoleQuery {
-	query := &UserQuery{config: urq.config}
+	query := (&UserClient{config: urq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -313,7 +320,7 @@ func (urq *UserRoleQuery) WithUser(opts ...func(*UserQuery)) *UserRoleQuery {
 // WithRole tells the query-builder to eager-load the nodes that are connected to
 // the "role" edge. The optional arguments are used to configure the query builder of the edge.
 func (urq *UserRoleQuery) WithRole(opts ...func(*RoleQuery)) *UserRoleQuery {
-	query := &RoleQuery{config: urq.config}
+	query := (&RoleClient{config: urq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
@@ -336,16 +343,11 @@ func (urq *UserRoleQuery) WithRole(opts ...func(*RoleQuery)) *UserRoleQuery {
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (urq *UserRoleQuery) GroupBy(field string, fields ...string) *UserRoleGroupBy {
-	grbuild := &UserRoleGroupBy{config: urq.config}
-	grbuild.fields = append([]string{field}, fields...)
-	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
-		if err := urq.prepareQuery(ctx); err != nil {
-			return nil, err
-		}
-		return urq.sqlQuery(ctx), nil
-	}
+	urq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserRoleGroupBy{build: urq}
+	grbuild.flds = &urq.ctx.Fields
 	grbuild.label = userrole.Label
-	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	grbuild.scan = grbuild.Scan
 	return grbuild
 }
 
@@ -362,11 +364,11 @@ func (urq *UserRoleQuery) GroupBy(field string, fields ...string) *UserRoleGroup
 //		Select(userrole.FieldCreatedBy).
 //		Scan(ctx, &v)
 func (urq *UserRoleQuery) Select(fields ...string) *UserRoleSelect {
-	urq.fields = append(urq.fields, fields...)
-	selbuild := &UserRoleSelect{UserRoleQuery: urq}
-	selbuild.label = userrole.Label
-	selbuild.flds, selbuild.scan = &urq.fields, selbuild.Scan
-	return selbuild
+	urq.ctx.Fields = append(urq.ctx.Fields, fields...)
+	sbuild := &UserRoleSelect{UserRoleQuery: urq}
+	sbuild.label = userrole.Label
+	sbuild.flds, sbuild.scan = &urq.ctx.Fields, sbuild.Scan
+	return sbuild
 }
 
 // Aggregate returns a UserRoleSelect configured with the given aggregations.
@@ -375,7 +377,17 @@ func (urq *UserRoleQuery) Aggregate(fns ...AggregateFunc) *UserRoleSelect {
 }
 
 func (urq *UserRoleQuery) prepareQuery(ctx context.Context) error {
-	for _, f := range urq.fields {
+	for _, inter := range urq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, urq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range urq.ctx.Fields {
 		if !userrole.ValidColumn(f) {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
@@ -450,6 +462,9 @@ func (urq *UserRoleQuery) loadUser(ctx context.Context, query *UserQuery, nodes
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
+	if len(ids) == 0 {
+		return nil
+	}
 	query.Where(user.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -476,6 +491,9 @@ func (urq *UserRoleQuery) loadRole(ctx context.Context, query *RoleQuery, nodes
 		}
 		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
+	if len(ids) == 0 {
+		return nil
+	}
 	query.Where(role.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -498,24 +516,13 @@ func (urq *UserRoleQuery) sqlCount(ctx context.Context) (int, error) {
 	if len(urq.modifiers) > 0 {
 		_spec.Modifiers = urq.modifiers
 	}
-	_spec.Node.Columns = urq.fields
-	if len(urq.fields) > 0 {
-		_spec.Unique = urq.unique != nil && *urq.unique
+	_spec.Node.Columns = urq.ctx.Fields
+	if len(urq.ctx.Fields) > 0 {
+		_spec.Unique = urq.ctx.Unique != nil && *urq.ctx.Unique
 	}
 	return sqlgraph.CountNodes(ctx, urq.driver, _spec)
 }
 
-func (urq *UserRoleQuery) sqlExist(ctx context.Context) (bool, error) {
-	switch _, err := urq.FirstID(ctx); {
-	case IsNotFound(err):
-		return false, nil
-	case err != nil:
-		return false, fmt.Errorf("ent: check existence: %w", err)
-	default:
-		return true, nil
-	}
-}
-
 func (urq *UserRoleQuery) querySpec() *sqlgraph.QuerySpec {
 	_spec := &sqlgraph.QuerySpec{
 		Node: &sqlgraph.NodeSpec{
@@ -529,10 +536,10 @@ func (urq *UserRoleQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   urq.sql,
 		Unique: true,
 	}
-	if unique := urq.unique; unique != nil {
+	if unique := urq.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
 	}
-	if fields := urq.fields; len(fields) > 0 {
+	if fields := urq.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, userrole.FieldID)
 		for i := range fields {
@@ -548,10 +555,10 @@ func (urq *UserRoleQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
-	if limit := urq.limit; limit != nil {
+	if limit := urq.ctx.Limit; limit != nil {
 		_spec.Limit = *limit
 	}
-	if offset := urq.offset; offset != nil {
+	if offset := urq.ctx.Offset; offset != nil {
 		_spec.Offset = *offset
 	}
 	if ps := urq.order; len(ps) > 0 {
@@ -567,7 +574,7 @@ func (urq *UserRoleQuery) querySpec() *sqlgraph.QuerySpec {
 func (urq *UserRoleQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	builder := sql.Dialect(urq.driver.Dialect())
 	t1 := builder.Table(userrole.Table)
-	columns := urq.fields
+	columns := urq.ctx.Fields
 	if len(columns) == 0 {
 		columns = userrole.Columns
 	}
@@ -576,35 +583,59 @@ func (urq *UserRoleQuery) sqlQuery(ctx context.Context) *sql.Selector {
 		selector = urq.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
-	if urq.unique != nil && *urq.unique {
+	if urq.ctx.Unique != nil && *urq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range urq.modifiers {
+		m(selector)
+	}
 	for _, p := range urq.predicates {
 		p(selector)
 	}
 	for _, p := range urq.order {
 		p(selector)
 	}
-	if offset := urq.offset; offset != nil {
+	if offset := urq.ctx.Offset; offset != nil {
 		// limit is mandatory for offset clause. We start
 		// with default value, and override it below if needed.
 		selector.Offset(*offset).Limit(math.MaxInt32)
 	}
-	if limit := urq.limit; limit != nil {
+	if limit := urq.ctx.Limit; limit != nil {
 		selector.Limit(*limit)
 	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (urq *UserRoleQuery) ForUpdate(opts ...sql.LockOption) *UserRoleQuery {
+	if urq.driver.Dialect() == dialect.Postgres {
+		urq.Unique(false)
+	}
+	urq.modifiers = append(urq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return urq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (urq *UserRoleQuery) ForShare(opts ...sql.LockOption) *UserRoleQuery {
+	if urq.driver.Dialect() == dialect.Postgres {
+		urq.Unique(false)
+	}
+	urq.modifiers = append(urq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return urq
+}
+
 // UserRoleGroupBy is the group-by builder for UserRole entities.
 type UserRoleGroupBy struct {
-	config
 	selector
-	fields []string
-	fns    []AggregateFunc
-	// intermediate query (i.e. traversal path).
-	sql  *sql.Selector
-	path func(context.Context) (*sql.Selector, error)
+	build *UserRoleQuery
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
@@ -613,58 +644,46 @@ func (urgb *UserRoleGroupBy) Aggregate(fns ...AggregateFunc) *UserRoleGroupBy {
 	return urgb
 }
 
-// Scan applies the group-by query and scans the result into the given value.
+// Scan applies the selector query and scans the result into the given value.
 func (urgb *UserRoleGroupBy) Scan(ctx context.Context, v any) error {
-	query, err := urgb.path(ctx)
-	if err != nil {
+	ctx = setContextOp(ctx, urgb.build.ctx, "GroupBy")
+	if err := urgb.build.prepareQuery(ctx); err != nil {
 		return err
 	}
-	urgb.sql = query
-	return urgb.sqlScan(ctx, v)
+	return scanWithInterceptors[*UserRoleQuery, *UserRoleGroupBy](ctx, urgb.build, urgb, urgb.build.inters, v)
 }
 
-func (urgb *UserRoleGroupBy) sqlScan(ctx context.Context, v any) error {
-	for _, f := range urgb.fields {
-		if !userrole.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+func (urgb *UserRoleGroupBy) sqlScan(ctx context.Context, root *UserRoleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(urgb.fns))
+	for _, fn := range urgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*urgb.flds)+len(urgb.fns))
+		for _, f := range *urgb.flds {
+			columns = append(columns, selector.C(f))
 		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
 	}
-	selector := urgb.sqlQuery()
+	selector.GroupBy(selector.Columns(*urgb.flds...)...)
 	if err := selector.Err(); err != nil {
 		return err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := urgb.driver.Query(ctx, query, args, rows); err != nil {
+	if err := urgb.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
 
-func (urgb *UserRoleGroupBy) sqlQuery() *sql.Selector {
-	selector := urgb.sql.Select()
-	aggregation := make([]string, 0, len(urgb.fns))
-	for _, fn := range urgb.fns {
-		aggregation = append(aggregation, fn(selector))
-	}
-	if len(selector.SelectedColumns()) == 0 {
-		columns := make([]string, 0, len(urgb.fields)+len(urgb.fns))
-		for _, f := range urgb.fields {
-			columns = append(columns, selector.C(f))
-		}
-		columns = append(columns, aggregation...)
-		selector.Select(columns...)
-	}
-	return selector.GroupBy(selector.Columns(urgb.fields...)...)
-}
-
 // UserRoleSelect is the builder for selecting fields of UserRole entities.
 type UserRoleSelect struct {
 	*UserRoleQuery
 	selector
-	// intermediate query (i.e. traversal path).
-	sql *sql.Selector
 }
 
 // Aggregate adds the given aggregation functions to the selector query.
@@ -675,26 +694,27 @@ func (urs *UserRoleSelect) Aggregate(fns ...AggregateFunc) *UserRoleSelect {
 
 // Scan applies the selector query and scans the result into the given value.
 func (urs *UserRoleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, urs.ctx, "Select")
 	if err := urs.prepareQuery(ctx); err != nil {
 		return err
 	}
-	urs.sql = urs.UserRoleQuery.sqlQuery(ctx)
-	return urs.sqlScan(ctx, v)
+	return scanWithInterceptors[*UserRoleQuery, *UserRoleSelect](ctx, urs.UserRoleQuery, urs, urs.inters, v)
 }
 
-func (urs *UserRoleSelect) sqlScan(ctx context.Context, v any) error {
+func (urs *UserRoleSelect) sqlScan(ctx context.Context, root *UserRoleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
 	aggregation := make([]string, 0, len(urs.fns))
 	for _, fn := range urs.fns {
-		aggregation = append(aggregation, fn(urs.sql))
+		aggregation = append(aggregation, fn(selector))
 	}
 	switch n := len(*urs.selector.flds); {
 	case n == 0 && len(aggregation) > 0:
-		urs.sql.Select(aggregation...)
+		selector.Select(aggregation...)
 	case n != 0 && len(aggregation) > 0:
-		urs.sql.AppendSelect(aggregation...)
+		selector.AppendSelect(aggregation...)
 	}
 	rows := &sql.Rows{}
-	query, args := urs.sql.Query()
+	query, args := selector.Query()
 	if err := urs.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -104,6 +104,34 @@ func (uru *UserRoleUpdate) SetRoleID(i int64) *UserRoleUpdate {
 	return uru
 }
 
+// SetValidFrom sets the "valid_from" field.
+func (uru *UserRoleUpdate) SetValidFrom(t time.Time) *UserRoleUpdate {
+	uru.mutation.SetValidFrom(t)
+	return uru
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (uru *UserRoleUpdate) SetNillableValidFrom(t *time.Time) *UserRoleUpdate {
+	if t != nil {
+		uru.SetValidFrom(*t)
+	}
+	return uru
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (uru *UserRoleUpdate) SetValidUntil(t time.Time) *UserRoleUpdate {
+	uru.mutation.SetValidUntil(t)
+	return uru
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (uru *UserRoleUpdate) SetNillableValidUntil(t *time.Time) *UserRoleUpdate {
+	if t != nil {
+		uru.SetValidUntil(*t)
+	}
+	return uru
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (uru *UserRoleUpdate) SetUser(u *User) *UserRoleUpdate {
 	return uru.SetUserID(u.ID)
@@ -133,41 +161,10 @@ func (uru *UserRoleUpdate) ClearRole() *UserRoleUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uru *UserRoleUpdate) Save(ctx context.Context) (int, error) {
-	var (
-		err      error
-		affected int
-	)
-	uru.defaults()
-	if len(uru.hooks) == 0 {
-		if err = uru.check(); err != nil {
-			return 0, err
-		}
-		affected, err = uru.sqlSave(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*UserRoleMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			if err = uru.check(); err != nil {
-				return 0, err
-			}
-			uru.mutation = mutation
-			affected, err = uru.sqlSave(ctx)
-			mutation.done = true
-			return affected, err
-		})
-		for i := len(uru.hooks) - 1; i >= 0; i-- {
-			if uru.hooks[i] == nil {
-				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = uru.hooks[i](mut)
-		}
-		if _, err := mut.Mutate(ctx, uru.mutation); err != nil {
-			return 0, err
-		}
+	if err := uru.defaults(); err != nil {
+		return 0, err
 	}
-	return affected, err
+	return withHooks[int, UserRoleMutation](ctx, uru.sqlSave, uru.mutation, uru.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
@@ -193,11 +190,15 @@ func (uru *UserRoleUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (uru *UserRoleUpdate) defaults() {
+func (uru *UserRoleUpdate) defaults() error {
 	if _, ok := uru.mutation.UpdatedAt(); !ok {
+		if userrole.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized userrole.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := userrole.UpdateDefaultUpdatedAt()
 		uru.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -212,6 +213,9 @@ func (uru *UserRoleUpdate) check() error {
 }
 
 func (uru *UserRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uru.check(); err != nil {
+		return n, err
+	}
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   userrole.Table,
@@ -247,6 +251,12 @@ func (uru *UserRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uru.mutation.DeletedAt(); ok {
 		_spec.SetField(userrole.FieldDeletedAt, field.TypeTime, value)
 	}
+	if value, ok := uru.mutation.ValidFrom(); ok {
+		_spec.SetField(userrole.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := uru.mutation.ValidUntil(); ok {
+		_spec.SetField(userrole.FieldValidUntil, field.TypeTime, value)
+	}
 	if uru.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -325,6 +335,7 @@ func (uru *UserRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		return 0, err
 	}
+	uru.mutation.done = true
 	return n, nil
 }
 
@@ -410,6 +421,34 @@ func (uruo *UserRoleUpdateOne) SetRoleID(i int64) *UserRoleUpdateOne {
 	return uruo
 }
 
+// SetValidFrom sets the "valid_from" field.
+func (uruo *UserRoleUpdateOne) SetValidFrom(t time.Time) *UserRoleUpdateOne {
+	uruo.mutation.SetValidFrom(t)
+	return uruo
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (uruo *UserRoleUpdateOne) SetNillableValidFrom(t *time.Time) *UserRoleUpdateOne {
+	if t != nil {
+		uruo.SetValidFrom(*t)
+	}
+	return uruo
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (uruo *UserRoleUpdateOne) SetValidUntil(t time.Time) *UserRoleUpdateOne {
+	uruo.mutation.SetValidUntil(t)
+	return uruo
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (uruo *UserRoleUpdateOne) SetNillableValidUntil(t *time.Time) *UserRoleUpdateOne {
+	if t != nil {
+		uruo.SetValidUntil(*t)
+	}
+	return uruo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (uruo *UserRoleUpdateOne) SetUser(u *User) *UserRoleUpdateOne {
 	return uruo.SetUserID(u.ID)
@@ -446,47 +485,10 @@ func (uruo *UserRoleUpdateOne) Select(field string, fields ...string) *UserRoleU
 
 // Save executes the query and returns the updated UserRole entity.
 func (uruo *UserRoleUpdateOne) Save(ctx context.Context) (*UserRole, error) {
-	var (
-		err  error
-		node *UserRole
-	)
-	uruo.defaults()
-	if len(uruo.hooks) == 0 {
-		if err = uruo.check(); err != nil {
-			return nil, err
-		}
-		node, err = uruo.sqlSave(ctx)
-	} else {
-		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-			mutation, ok := m.(*UserRoleMutation)
-			if !ok {
-				return nil, fmt.Errorf("unexpected mutation type %T", m)
-			}
-			if err = uruo.check(); err != nil {
-				return nil, err
-			}
-			uruo.mutation = mutation
-			node, err = uruo.sqlSave(ctx)
-			mutation.done = true
-			return node, err
-		})
-		for i := len(uruo.hooks) - 1; i >= 0; i-- {
-			if uruo.hooks[i] == nil {
-				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = uruo.hooks[i](mut)
-		}
-		v, err := mut.Mutate(ctx, uruo.mutation)
-		if err != nil {
-			return nil, err
-		}
-		nv, ok := v.(*UserRole)
-		if !ok {
-			return nil, fmt.Errorf("unexpected node type %T returned from UserRoleMutation", v)
-		}
-		node = nv
+	if err := uruo.defaults(); err != nil {
+		return nil, err
 	}
-	return node, err
+	return withHooks[*UserRole, UserRoleMutation](ctx, uruo.sqlSave, uruo.mutation, uruo.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
@@ -512,11 +514,15 @@ func (uruo *UserRoleUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (uruo *UserRoleUpdateOne) defaults() {
+func (uruo *UserRoleUpdateOne) defaults() error {
 	if _, ok := uruo.mutation.UpdatedAt(); !ok {
+		if userrole.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized userrole.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := userrole.UpdateDefaultUpdatedAt()
 		uruo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -531,6 +537,9 @@ func (uruo *UserRoleUpdateOne) check() error {
 }
 
 func (uruo *UserRoleUpdateOne) sqlSave(ctx context.Context) (_node *UserRole, err error) {
+	if err := uruo.check(); err != nil {
+		return _node, err
+	}
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   userrole.Table,
@@ -583,6 +592,12 @@ func (uruo *UserRoleUpdateOne) sqlSave(ctx context.Context) (_node *UserRole, er
 	if value, ok := uruo.mutation.DeletedAt(); ok {
 		_spec.SetField(userrole.FieldDeletedAt, field.TypeTime, value)
 	}
+	if value, ok := uruo.mutation.ValidFrom(); ok {
+		_spec.SetField(userrole.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := uruo.mutation.ValidUntil(); ok {
+		_spec.SetField(userrole.FieldValidUntil, field.TypeTime, value)
+	}
 	if uruo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -664,5 +679,6 @@ func (uruo *UserRoleUpdateOne) sqlSave(ctx context.Context) (_node *UserRole, er
 		}
 		return nil, err
 	}
+	uruo.mutation.done = true
 	return _node, nil
 }
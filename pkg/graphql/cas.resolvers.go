@@ -6,65 +6,114 @@ package graphql
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/pkg/abac"
 	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
 	"github.com/stark-sim/cas/pkg/ent/role"
+	"github.com/stark-sim/cas/pkg/ent/schema"
 	"github.com/stark-sim/cas/pkg/ent/user"
 	"github.com/stark-sim/cas/pkg/ent/userrole"
 	"github.com/stark-sim/cas/pkg/graphql/middlewares"
 	"github.com/stark-sim/cas/pkg/graphql/model"
+	"github.com/stark-sim/cas/pkg/metrics"
+	"github.com/stark-sim/cas/pkg/outbox"
+	"github.com/stark-sim/cas/pkg/rbac"
+	"github.com/stark-sim/cas/pkg/webhook"
 	"github.com/stark-sim/cas/tools"
 )
 
+// ID is the resolver for the id field.
+func (r *accessPolicyResolver) ID(ctx context.Context, obj *ent.AccessPolicy) (string, error) {
+	return strconv.FormatInt(obj.ID, 10), nil
+}
+
+// CreatedBy is the resolver for the createdBy field.
+func (r *accessPolicyResolver) CreatedBy(ctx context.Context, obj *ent.AccessPolicy) (string, error) {
+	if obj.CreatedBy == 0 {
+		return "", nil
+	} else {
+		return strconv.FormatInt(obj.CreatedBy, 10), nil
+	}
+}
+
+// UpdatedBy is the resolver for the updatedBy field.
+func (r *accessPolicyResolver) UpdatedBy(ctx context.Context, obj *ent.AccessPolicy) (string, error) {
+	if obj.UpdatedBy == 0 {
+		return "", nil
+	} else {
+		return strconv.FormatInt(obj.UpdatedBy, 10), nil
+	}
+}
+
+// ID is the resolver for the id field.
+func (r *auditEventResolver) ID(ctx context.Context, obj *ent.AuditEvent) (string, error) {
+	return strconv.FormatInt(obj.ID, 10), nil
+}
+
+// ActorID is the resolver for the actorID field.
+func (r *auditEventResolver) ActorID(ctx context.Context, obj *ent.AuditEvent) (string, error) {
+	return strconv.FormatInt(obj.ActorID, 10), nil
+}
+
+// EntityID is the resolver for the entityID field.
+func (r *auditEventResolver) EntityID(ctx context.Context, obj *ent.AuditEvent) (string, error) {
+	return strconv.FormatInt(obj.EntityID, 10), nil
+}
+
 // CreateRole is the resolver for the createRole field.
 func (r *mutationResolver) CreateRole(ctx context.Context, input ent.CreateRoleInput) (*ent.Role, error) {
-	return r.client.Role.Create().SetInput(input).Save(ctx)
+	return r.db(ctx).Role.Create().SetInput(input).Save(ctx)
 }
 
 // UpdateRole is the resolver for the updateRole field.
 func (r *mutationResolver) UpdateRole(ctx context.Context, id string, input ent.UpdateRoleInput) (*ent.Role, error) {
 	tempID := tools.StringToInt64(id)
-	return r.client.Role.UpdateOneID(tempID).SetInput(input).Save(ctx)
+	return r.db(ctx).Role.UpdateOneID(tempID).SetInput(input).Save(ctx)
 }
 
 // DeleteRole is the resolver for the deleteRole field.
 func (r *mutationResolver) DeleteRole(ctx context.Context, id string) (*ent.Role, error) {
 	tempID := tools.StringToInt64(id)
-	return r.client.Role.UpdateOneID(tempID).SetDeletedAt(time.Now()).Save(ctx)
+	return rbac.DeleteRole(ctx, r.db(ctx), tempID)
 }
 
 // CreateUser is the resolver for the createUser field.
 func (r *mutationResolver) CreateUser(ctx context.Context, input ent.CreateUserInput) (*ent.User, error) {
-	return r.client.User.Create().SetInput(input).Save(ctx)
+	return r.db(ctx).User.Create().SetInput(input).Save(ctx)
 }
 
 // UpdateUser is the resolver for the updateUser field.
 func (r *mutationResolver) UpdateUser(ctx context.Context, id string, input ent.UpdateUserInput) (*ent.User, error) {
 	tempID := tools.StringToInt64(id)
-	return r.client.User.UpdateOneID(tempID).SetInput(input).Save(ctx)
+	return r.db(ctx).User.UpdateOneID(tempID).SetInput(input).Save(ctx)
 }
 
 // DeleteUser is the resolver for the deleteUser field.
 func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (*ent.User, error) {
 	tempID := tools.StringToInt64(id)
-	return r.client.User.UpdateOneID(tempID).SetDeletedAt(time.Now()).Save(ctx)
+	return rbac.DeleteUser(ctx, r.db(ctx), tempID)
 }
 
 // Register is the resolver for the register field.
 func (r *mutationResolver) Register(ctx context.Context, req model.RegisterReq) (*ent.User, error) {
 	// 先查看有没有重复的手机号用户存在
-	_, err := r.client.User.Query().Where(user.Phone(req.Phone), user.DeletedAtEQ(tools.ZeroTime)).First(ctx)
+	_, err := r.db(ctx).User.Query().Where(user.Phone(req.Phone)).First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return r.client.User.Create().SetName(req.Name).SetPhone(req.Phone).Save(ctx)
+			_user, err := r.db(ctx).User.Create().SetName(req.Name).SetPhone(req.Phone).Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rbac.AfterCommit(ctx, metrics.Registration)
+			return _user, nil
 		} else {
-			logrus.Errorf("err at check existing phone: %v", err)
+			logrus.WithContext(ctx).Errorf("err at check existing phone: %v", err)
 			return nil, err
 		}
 	} else {
@@ -72,17 +121,176 @@ func (r *mutationResolver) Register(ctx context.Context, req model.RegisterReq)
 	}
 }
 
+// CreateAccessPolicy is the resolver for the createAccessPolicy field.
+func (r *mutationResolver) CreateAccessPolicy(ctx context.Context, input ent.CreateAccessPolicyInput) (*ent.AccessPolicy, error) {
+	if err := r.authorize(ctx, "manage", "policy"); err != nil {
+		return nil, err
+	}
+	return r.db(ctx).AccessPolicy.Create().SetInput(input).Save(ctx)
+}
+
+// UpdateAccessPolicy is the resolver for the updateAccessPolicy field.
+func (r *mutationResolver) UpdateAccessPolicy(ctx context.Context, id string, input ent.UpdateAccessPolicyInput) (*ent.AccessPolicy, error) {
+	if err := r.authorize(ctx, "manage", "policy"); err != nil {
+		return nil, err
+	}
+	tempID := tools.StringToInt64(id)
+	return r.db(ctx).AccessPolicy.UpdateOneID(tempID).SetInput(input).Save(ctx)
+}
+
+// DeleteAccessPolicy is the resolver for the deleteAccessPolicy field.
+func (r *mutationResolver) DeleteAccessPolicy(ctx context.Context, id string) (*ent.AccessPolicy, error) {
+	if err := r.authorize(ctx, "manage", "policy"); err != nil {
+		return nil, err
+	}
+	tempID := tools.StringToInt64(id)
+	return r.db(ctx).AccessPolicy.UpdateOneID(tempID).SetDeletedAt(time.Now()).Save(ctx)
+}
+
+// GrantRole is the resolver for the grantRole field.
+func (r *mutationResolver) GrantRole(ctx context.Context, req model.GrantRoleReq) (*ent.UserRole, error) {
+	if err := r.authorize(ctx, "grant", "role"); err != nil {
+		return nil, err
+	}
+	validFrom, validUntil := tools.ZeroTime, tools.ZeroTime
+	if req.ValidFrom != nil {
+		validFrom = *req.ValidFrom
+	}
+	if req.ValidUntil != nil {
+		validUntil = *req.ValidUntil
+	}
+	return rbac.Grant(ctx, r.db(ctx), tools.StringToInt64(req.UserID), tools.StringToInt64(req.RoleID), validFrom, validUntil)
+}
+
+// RevokeRole is the resolver for the revokeRole field.
+func (r *mutationResolver) RevokeRole(ctx context.Context, userID string, roleID string) (*ent.UserRole, error) {
+	if err := r.authorize(ctx, "revoke", "role"); err != nil {
+		return nil, err
+	}
+	_userRole, err := r.db(ctx).UserRole.Query().Where(
+		userrole.UserID(tools.StringToInt64(userID)),
+		userrole.RoleID(tools.StringToInt64(roleID)),
+	).First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return _userRole.Update().SetDeletedAt(time.Now()).Save(ctx)
+}
+
+// RestoreUser is the resolver for the restoreUser field.
+func (r *mutationResolver) RestoreUser(ctx context.Context, id string, withAssignments *bool) (*ent.User, error) {
+	if err := r.authorize(ctx, "purge", "user"); err != nil {
+		return nil, err
+	}
+	tempID := tools.StringToInt64(id)
+	return rbac.RestoreUser(ctx, r.db(ctx), tempID, withAssignments != nil && *withAssignments)
+}
+
+// RestoreRole is the resolver for the restoreRole field.
+func (r *mutationResolver) RestoreRole(ctx context.Context, id string, withAssignments *bool) (*ent.Role, error) {
+	if err := r.authorize(ctx, "purge", "role"); err != nil {
+		return nil, err
+	}
+	tempID := tools.StringToInt64(id)
+	return rbac.RestoreRole(ctx, r.db(ctx), tempID, withAssignments != nil && *withAssignments)
+}
+
+// PurgeUser is the resolver for the purgeUser field.
+func (r *mutationResolver) PurgeUser(ctx context.Context, id string) (bool, error) {
+	if err := r.authorize(ctx, "purge", "user"); err != nil {
+		return false, err
+	}
+	if err := rbac.PurgeUser(ctx, r.db(ctx), tools.StringToInt64(id)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// PurgeRole is the resolver for the purgeRole field.
+func (r *mutationResolver) PurgeRole(ctx context.Context, id string) (bool, error) {
+	if err := r.authorize(ctx, "purge", "role"); err != nil {
+		return false, err
+	}
+	if err := rbac.PurgeRole(ctx, r.db(ctx), tools.StringToInt64(id)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateWebhookSubscription is the resolver for the createWebhookSubscription field.
+func (r *mutationResolver) CreateWebhookSubscription(ctx context.Context, input ent.CreateWebhookSubscriptionInput) (*ent.WebhookSubscription, error) {
+	if err := r.authorize(ctx, "manage", "webhook"); err != nil {
+		return nil, err
+	}
+	return r.db(ctx).WebhookSubscription.Create().SetInput(input).Save(ctx)
+}
+
+// UpdateWebhookSubscription is the resolver for the updateWebhookSubscription field.
+func (r *mutationResolver) UpdateWebhookSubscription(ctx context.Context, id string, input ent.UpdateWebhookSubscriptionInput) (*ent.WebhookSubscription, error) {
+	if err := r.authorize(ctx, "manage", "webhook"); err != nil {
+		return nil, err
+	}
+	tempID := tools.StringToInt64(id)
+	return r.db(ctx).WebhookSubscription.UpdateOneID(tempID).SetInput(input).Save(ctx)
+}
+
+// DeleteWebhookSubscription is the resolver for the deleteWebhookSubscription field.
+func (r *mutationResolver) DeleteWebhookSubscription(ctx context.Context, id string) (*ent.WebhookSubscription, error) {
+	if err := r.authorize(ctx, "manage", "webhook"); err != nil {
+		return nil, err
+	}
+	tempID := tools.StringToInt64(id)
+	return r.db(ctx).WebhookSubscription.UpdateOneID(tempID).SetDeletedAt(time.Now()).Save(ctx)
+}
+
+// ReplayWebhookDelivery is the resolver for the replayWebhookDelivery field.
+func (r *mutationResolver) ReplayWebhookDelivery(ctx context.Context, id string) (*ent.WebhookDelivery, error) {
+	if err := r.authorize(ctx, "manage", "webhook"); err != nil {
+		return nil, err
+	}
+	return webhook.Replay(ctx, r.db(ctx), tools.StringToInt64(id))
+}
+
+// ReplayOutboxEvent is the resolver for the replayOutboxEvent field.
+func (r *mutationResolver) ReplayOutboxEvent(ctx context.Context, id string) (*ent.OutboxEvent, error) {
+	if err := r.authorize(ctx, "manage", "outbox"); err != nil {
+		return nil, err
+	}
+	return outbox.Replay(ctx, r.db(ctx), tools.StringToInt64(id))
+}
+
+// ID is the resolver for the id field.
+func (r *outboxEventResolver) ID(ctx context.Context, obj *ent.OutboxEvent) (string, error) {
+	return strconv.FormatInt(obj.ID, 10), nil
+}
+
+// AggregateID is the resolver for the aggregateID field.
+func (r *outboxEventResolver) AggregateID(ctx context.Context, obj *ent.OutboxEvent) (string, error) {
+	return strconv.FormatInt(obj.AggregateID, 10), nil
+}
+
 // Node is the resolver for the node field.
 func (r *queryResolver) Node(ctx context.Context, id string) (ent.Noder, error) {
 	tempID := tools.StringToInt64(id)
-	_user, err := r.client.User.Query().Where(user.ID(tempID), user.DeletedAtEQ(tools.ZeroTime)).First(ctx)
+	_user, err := r.client.User.Query().Where(user.ID(tempID)).First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			_role, err := r.client.Role.Query().Where(role.ID(tempID), role.DeletedAtEQ(tools.ZeroTime)).First(ctx)
+			_role, err := r.client.Role.Query().Where(role.ID(tempID)).First(ctx)
 			if err != nil {
 				if ent.IsNotFound(err) {
-					userRole, err := r.client.UserRole.Query().Where(userrole.ID(tempID), userrole.DeletedAtEQ(tools.ZeroTime)).First(ctx)
+					userRole, err := r.client.UserRole.Query().Where(userrole.ID(tempID)).First(ctx)
 					if err != nil {
+						if ent.IsNotFound(err) {
+							policy, err := r.client.AccessPolicy.Query().Where(accesspolicy.ID(tempID)).First(ctx)
+							if err != nil {
+								return nil, err
+							}
+							// 与 accessPolicies 相同的权限
+							if err = r.authorize(ctx, "manage", "policy"); err != nil {
+								return nil, err
+							}
+							return policy, nil
+						}
 						return nil, err
 					}
 					return userRole, nil
@@ -104,7 +312,7 @@ func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]ent.Noder, e
 		tempIDs = append(tempIDs, tools.StringToInt64(v))
 	}
 	// User
-	users, err := r.client.User.Query().Where(user.IDIn(tempIDs...), user.DeletedAtEQ(tools.ZeroTime)).All(ctx)
+	users, err := r.client.User.Query().Where(user.IDIn(tempIDs...)).All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +320,7 @@ func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]ent.Noder, e
 		res = append(res, v)
 	}
 	// Role
-	roles, err := r.client.Role.Query().Where(role.IDIn(tempIDs...), role.DeletedAtEQ(tools.ZeroTime)).All(ctx)
+	roles, err := r.client.Role.Query().Where(role.IDIn(tempIDs...)).All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -120,38 +328,99 @@ func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]ent.Noder, e
 		res = append(res, v)
 	}
 	// UserRole
-	userRoles, err := r.client.UserRole.Query().Where(userrole.IDIn(tempIDs...), userrole.DeletedAtEQ(tools.ZeroTime)).All(ctx)
+	userRoles, err := r.client.UserRole.Query().Where(userrole.IDIn(tempIDs...)).All(ctx)
 	if err != nil {
 		return nil, err
 	}
 	for _, v := range userRoles {
 		res = append(res, v)
 	}
+	// AccessPolicy
+	accessPolicies, err := r.client.AccessPolicy.Query().Where(accesspolicy.IDIn(tempIDs...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(accessPolicies) > 0 {
+		// 与 accessPolicies 相同的权限
+		if err = r.authorize(ctx, "manage", "policy"); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range accessPolicies {
+		res = append(res, v)
+	}
 	// return
 	return res, nil
 }
 
+// AccessPolicies is the resolver for the accessPolicies field.
+func (r *queryResolver) AccessPolicies(ctx context.Context) ([]*ent.AccessPolicy, error) {
+	if err := r.authorize(ctx, "manage", "policy"); err != nil {
+		return nil, err
+	}
+	return r.client.AccessPolicy.Query().Order(ent.Asc(accesspolicy.FieldID)).Limit(MaxPageSize).All(ctx)
+}
+
+// AuditEvents is the resolver for the auditEvents field.
+func (r *queryResolver) AuditEvents(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.AuditEventOrder, where *ent.AuditEventWhereInput) (*ent.AuditEventConnection, error) {
+	if err := r.authorize(ctx, "read", "audit"); err != nil {
+		return nil, err
+	}
+	first, last = limitPage(first, last)
+	// 默认按时间倒序
+	if orderBy == nil {
+		orderBy = &ent.AuditEventOrder{Direction: ent.OrderDirectionDesc, Field: ent.AuditEventOrderFieldCreatedAt}
+	}
+	return r.client.AuditEvent.Query().Paginate(ctx, after, first, before, last, ent.WithAuditEventOrder(orderBy), ent.WithAuditEventFilter(where.Filter))
+}
+
 // Roles is the resolver for the roles field.
-func (r *queryResolver) Roles(ctx context.Context) ([]*ent.Role, error) {
-	return r.client.Role.Query().Where(role.DeletedAtEQ(tools.ZeroTime)).All(ctx)
+func (r *queryResolver) Roles(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.RoleOrder, where *ent.RoleWhereInput) (*ent.RoleConnection, error) {
+	first, last = limitPage(first, last)
+	return r.client.Role.Query().Paginate(ctx, after, first, before, last, ent.WithRoleOrder(orderBy), ent.WithRoleFilter(where.Filter))
 }
 
 // Users is the resolver for the users field.
-func (r *queryResolver) Users(ctx context.Context) ([]*ent.User, error) {
-	return r.client.User.Query().Where(user.DeletedAtEQ(tools.ZeroTime)).All(ctx)
+func (r *queryResolver) Users(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.UserOrder, where *ent.UserWhereInput) (*ent.UserConnection, error) {
+	first, last = limitPage(first, last)
+	return r.client.User.Query().Paginate(ctx, after, first, before, last, ent.WithUserOrder(orderBy), ent.WithUserFilter(where.Filter))
+}
+
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.WebhookDeliveryOrder, where *ent.WebhookDeliveryWhereInput) (*ent.WebhookDeliveryConnection, error) {
+	if err := r.authorize(ctx, "manage", "webhook"); err != nil {
+		return nil, err
+	}
+	first, last = limitPage(first, last)
+	// 默认按时间倒序
+	if orderBy == nil {
+		orderBy = &ent.WebhookDeliveryOrder{Direction: ent.OrderDirectionDesc, Field: ent.WebhookDeliveryOrderFieldCreatedAt}
+	}
+	return r.client.WebhookDelivery.Query().Paginate(ctx, after, first, before, last, ent.WithWebhookDeliveryOrder(orderBy), ent.WithWebhookDeliveryFilter(where.Filter))
+}
+
+// WebhookSubscriptions is the resolver for the webhookSubscriptions field.
+func (r *queryResolver) WebhookSubscriptions(ctx context.Context) ([]*ent.WebhookSubscription, error) {
+	if err := r.authorize(ctx, "manage", "webhook"); err != nil {
+		return nil, err
+	}
+	return r.client.WebhookSubscription.Query().All(ctx)
 }
 
 // Login is the resolver for the login field.
 func (r *queryResolver) Login(ctx context.Context, req model.LoginReq) (*ent.User, error) {
-	_user, err := r.client.User.Query().Where(user.PhoneEQ(req.Phone), user.DeletedAtEQ(tools.ZeroTime)).First(ctx)
+	_user, err := r.client.User.Query().Where(user.PhoneEQ(req.Phone)).First(ctx)
 	if err != nil {
-		logrus.Errorf("login err: %v", err)
+		logrus.WithContext(ctx).Errorf("login err: %v", err)
+		if ent.IsNotFound(err) {
+			metrics.Login(false)
+		}
 		return nil, err
 	}
 	// 通过 用户 id 生成新 token
 	token, err := tools.GetToken(time.Now(), _user.ID)
 	if err != nil {
-		logrus.Errorf("get token err: %v", err)
+		logrus.WithContext(ctx).Errorf("get token err: %v", err)
 		return nil, err
 	}
 	// 将 token 包装成一个 cookie 返回
@@ -173,9 +442,55 @@ func (r *queryResolver) Login(ctx context.Context, req model.LoginReq) (*ent.Use
 	if err != nil {
 		return nil, err
 	}
+	metrics.Login(true)
 	return _user, nil
 }
 
+// Decide is the resolver for the decide field.
+func (r *queryResolver) Decide(ctx context.Context, req model.DecideReq) (*model.PolicyDecision, error) {
+	abacReq := abac.Request{
+		Action:             req.Action,
+		Resource:           req.Resource,
+		Subject:            req.Subject,
+		ResourceAttributes: req.ResourceAttributes,
+		Environment:        req.Environment,
+	}
+	if req.SubjectID != nil {
+		abacReq.SubjectID = tools.StringToInt64(*req.SubjectID)
+	}
+	decision, err := r.abac.Decide(ctx, abacReq)
+	if err != nil {
+		return nil, err
+	}
+	res := &model.PolicyDecision{
+		Allowed:   decision.Allowed,
+		PolicyIDs: make([]string, 0, len(decision.PolicyIDs)),
+	}
+	if decision.Effect != "" {
+		res.Effect = &decision.Effect
+	}
+	for _, v := range decision.PolicyIDs {
+		res.PolicyIDs = append(res.PolicyIDs, strconv.FormatInt(v, 10))
+	}
+	return res, nil
+}
+
+// DeletedUsers is the resolver for the deletedUsers field.
+func (r *queryResolver) DeletedUsers(ctx context.Context) ([]*ent.User, error) {
+	if err := r.authorize(ctx, "read_deleted", "user"); err != nil {
+		return nil, err
+	}
+	return r.client.User.Query().Where(user.DeletedAtNEQ(tools.ZeroTime)).All(schema.SkipSoftDelete(ctx))
+}
+
+// DeletedRoles is the resolver for the deletedRoles field.
+func (r *queryResolver) DeletedRoles(ctx context.Context) ([]*ent.Role, error) {
+	if err := r.authorize(ctx, "read_deleted", "role"); err != nil {
+		return nil, err
+	}
+	return r.client.Role.Query().Where(role.DeletedAtNEQ(tools.ZeroTime)).All(schema.SkipSoftDelete(ctx))
+}
+
 // ID is the resolver for the id field.
 func (r *roleResolver) ID(ctx context.Context, obj *ent.Role) (string, error) {
 	return strconv.FormatInt(obj.ID, 10), nil
@@ -199,6 +514,124 @@ func (r *roleResolver) UpdatedBy(ctx context.Context, obj *ent.Role) (string, er
 	}
 }
 
+// Users is the resolver for the users field.
+func (r *roleResolver) Users(ctx context.Context, obj *ent.Role, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.UserOrder, where *ent.UserWhereInput) (*ent.UserConnection, error) {
+	first, last = limitPage(first, last)
+	return rbac.RoleUsersQuery(r.client, obj.ID).Paginate(ctx, after, first, before, last, ent.WithUserOrder(orderBy), ent.WithUserFilter(where.Filter))
+}
+
+// UserRoles is the resolver for the userRoles field.
+func (r *roleResolver) UserRoles(ctx context.Context, obj *ent.Role) ([]*ent.UserRole, error) {
+	// 连接边的注解会带到 through 边上，这里仍按列表返回，最多 MaxPageSize 条，完整列表通过连接边分页
+	return obj.QueryUserRoles().Order(ent.Asc(userrole.FieldID)).Limit(MaxPageSize).All(ctx)
+}
+
+// UserChanged is the resolver for the userChanged field.
+func (r *subscriptionResolver) UserChanged(ctx context.Context, resumeToken *string) (<-chan *model.UserChange, error) {
+	if err := r.authorize(ctx, "read", "audit"); err != nil {
+		return nil, err
+	}
+	ch := make(chan *model.UserChange)
+	err := r.subscribe(ctx, resumeToken, []string{ent.TypeUser}, func(event *ent.AuditEvent) error {
+		_user, err := r.client.User.Get(ctx, event.EntityID)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		select {
+		case ch <- &model.UserChange{Event: event, User: _user}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}, func() { close(ch) })
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// RoleChanged is the resolver for the roleChanged field.
+func (r *subscriptionResolver) RoleChanged(ctx context.Context, resumeToken *string) (<-chan *model.RoleChange, error) {
+	if err := r.authorize(ctx, "read", "audit"); err != nil {
+		return nil, err
+	}
+	ch := make(chan *model.RoleChange)
+	err := r.subscribe(ctx, resumeToken, []string{ent.TypeRole}, func(event *ent.AuditEvent) error {
+		_role, err := r.client.Role.Get(ctx, event.EntityID)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		select {
+		case ch <- &model.RoleChange{Event: event, Role: _role}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}, func() { close(ch) })
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// MyRolesChanged is the resolver for the myRolesChanged field.
+func (r *subscriptionResolver) MyRolesChanged(ctx context.Context) (<-chan []*ent.Role, error) {
+	userID := tools.GetUserID(ctx)
+	if userID == 0 {
+		return nil, http.ErrNoCookie
+	}
+	// 角色或授权有变化时重新计算，限时授权生效不产生变更，所以同时定时检查
+	changed := make(chan struct{}, 1)
+	streamCtx, stopStream := context.WithCancel(ctx)
+	// 只关心自己的授权，其他用户的变更不推送
+	err := r.subscribe(streamCtx, nil, []string{ent.TypeRole, ent.TypeUserRole}, func(event *ent.AuditEvent) error {
+		concerned, err := r.concernsUser(ctx, event, userID)
+		if err != nil || !concerned {
+			return err
+		}
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+		return nil
+	}, stopStream)
+	if err != nil {
+		stopStream()
+		return nil, err
+	}
+	ch := make(chan []*ent.Role)
+	go func() {
+		defer close(ch)
+		defer stopStream()
+		ticker := time.NewTicker(rbac.DefaultSweepInterval)
+		defer ticker.Stop()
+		last, pushed := "", false
+		for {
+			roles, err := rbac.UserRolesQuery(r.client, userID).Order(ent.Asc(role.FieldID)).All(ctx)
+			if err != nil {
+				logrus.WithContext(ctx).Errorf("failed at loading roles of user %d, err: %v", userID, err)
+				return
+			}
+			// 首次推送当前角色，之后只在变化时推送
+			if key := rolesKey(roles); !pushed || key != last {
+				last, pushed = key, true
+				select {
+				case ch <- roles:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-streamCtx.Done():
+				return
+			case <-changed:
+			case <-ticker.C:
+			}
+		}
+	}()
+	return ch, nil
+}
+
 // ID is the resolver for the id field.
 func (r *userResolver) ID(ctx context.Context, obj *ent.User) (string, error) {
 	return strconv.FormatInt(obj.ID, 10), nil
@@ -222,6 +655,18 @@ func (r *userResolver) UpdatedBy(ctx context.Context, obj *ent.User) (string, er
 	}
 }
 
+// Roles is the resolver for the roles field.
+func (r *userResolver) Roles(ctx context.Context, obj *ent.User, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.RoleOrder, where *ent.RoleWhereInput) (*ent.RoleConnection, error) {
+	first, last = limitPage(first, last)
+	return rbac.UserRolesQuery(r.client, obj.ID).Paginate(ctx, after, first, before, last, ent.WithRoleOrder(orderBy), ent.WithRoleFilter(where.Filter))
+}
+
+// UserRoles is the resolver for the userRoles field.
+func (r *userResolver) UserRoles(ctx context.Context, obj *ent.User) ([]*ent.UserRole, error) {
+	// 连接边的注解会带到 through 边上，这里仍按列表返回，最多 MaxPageSize 条，完整列表通过连接边分页
+	return obj.QueryUserRoles().Order(ent.Asc(userrole.FieldID)).Limit(MaxPageSize).All(ctx)
+}
+
 // ID is the resolver for the id field.
 func (r *userRoleResolver) ID(ctx context.Context, obj *ent.UserRole) (string, error) {
 	return strconv.FormatInt(obj.ID, 10), nil
@@ -255,715 +700,2105 @@ func (r *userRoleResolver) RoleID(ctx context.Context, obj *ent.UserRole) (strin
 	return strconv.FormatInt(obj.RoleID, 10), nil
 }
 
-// CreatedBy is the resolver for the createdBy field.
-func (r *createRoleInputResolver) CreatedBy(ctx context.Context, obj *ent.CreateRoleInput, data *string) error {
-	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.CreatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
-	}
+// ID is the resolver for the id field.
+func (r *webhookDeliveryResolver) ID(ctx context.Context, obj *ent.WebhookDelivery) (string, error) {
+	return strconv.FormatInt(obj.ID, 10), nil
 }
 
-// UpdatedBy is the resolver for the updatedBy field.
-func (r *createRoleInputResolver) UpdatedBy(ctx context.Context, obj *ent.CreateRoleInput, data *string) error {
-	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.UpdatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
-	}
+// SubscriptionID is the resolver for the subscriptionID field.
+func (r *webhookDeliveryResolver) SubscriptionID(ctx context.Context, obj *ent.WebhookDelivery) (string, error) {
+	return strconv.FormatInt(obj.SubscriptionID, 10), nil
 }
 
-// UserIDs is the resolver for the userIDs field.
-func (r *createRoleInputResolver) UserIDs(ctx context.Context, obj *ent.CreateRoleInput, data []string) error {
-	for _, v := range data {
-		obj.UserIDs = append(obj.UserIDs, tools.StringToInt64(v))
-	}
-	return nil
+// EventID is the resolver for the eventID field.
+func (r *webhookDeliveryResolver) EventID(ctx context.Context, obj *ent.WebhookDelivery) (string, error) {
+	return strconv.FormatInt(obj.EventID, 10), nil
+}
+
+// ID is the resolver for the id field.
+func (r *webhookSubscriptionResolver) ID(ctx context.Context, obj *ent.WebhookSubscription) (string, error) {
+	return strconv.FormatInt(obj.ID, 10), nil
 }
 
 // CreatedBy is the resolver for the createdBy field.
-func (r *createUserInputResolver) CreatedBy(ctx context.Context, obj *ent.CreateUserInput, data *string) error {
-	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.CreatedBy = &tempID
-		return nil
+func (r *webhookSubscriptionResolver) CreatedBy(ctx context.Context, obj *ent.WebhookSubscription) (string, error) {
+	if obj.CreatedBy == 0 {
+		return "", nil
 	} else {
-		return errors.New("null")
+		return strconv.FormatInt(obj.CreatedBy, 10), nil
 	}
 }
 
 // UpdatedBy is the resolver for the updatedBy field.
-func (r *createUserInputResolver) UpdatedBy(ctx context.Context, obj *ent.CreateUserInput, data *string) error {
-	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.UpdatedBy = &tempID
-		return nil
+func (r *webhookSubscriptionResolver) UpdatedBy(ctx context.Context, obj *ent.WebhookSubscription) (string, error) {
+	if obj.UpdatedBy == 0 {
+		return "", nil
 	} else {
-		return errors.New("null")
-	}
-}
-
-// RoleIDs is the resolver for the roleIDs field.
-func (r *createUserInputResolver) RoleIDs(ctx context.Context, obj *ent.CreateUserInput, data []string) error {
-	for _, v := range data {
-		obj.RoleIDs = append(obj.RoleIDs, tools.StringToInt64(v))
+		return strconv.FormatInt(obj.UpdatedBy, 10), nil
 	}
-	return nil
 }
 
 // ID is the resolver for the id field.
-func (r *roleWhereInputResolver) ID(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *accessPolicyWhereInputResolver) ID(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.ID = &tempID
 	}
 	return nil
 }
 
 // IDNeq is the resolver for the idNEQ field.
-func (r *roleWhereInputResolver) IDNeq(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *accessPolicyWhereInputResolver) IDNeq(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDNEQ = &tempID
 	}
 	return nil
 }
 
 // IDIn is the resolver for the idIn field.
-func (r *roleWhereInputResolver) IDIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+func (r *accessPolicyWhereInputResolver) IDIn(ctx context.Context, obj *ent.AccessPolicyWhereInput, data []string) error {
 	for _, v := range data {
-		obj.IDIn = append(obj.IDIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDIn = append(obj.IDIn, tempID)
 	}
 	return nil
 }
 
 // IDNotIn is the resolver for the idNotIn field.
-func (r *roleWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+func (r *accessPolicyWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.AccessPolicyWhereInput, data []string) error {
 	for _, v := range data {
-		obj.IDNotIn = append(obj.IDNotIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDNotIn = append(obj.IDNotIn, tempID)
 	}
 	return nil
 }
 
 // IDGt is the resolver for the idGT field.
-func (r *roleWhereInputResolver) IDGt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *accessPolicyWhereInputResolver) IDGt(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDGT = &tempID
 	}
 	return nil
 }
 
 // IDGte is the resolver for the idGTE field.
-func (r *roleWhereInputResolver) IDGte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *accessPolicyWhereInputResolver) IDGte(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDGTE = &tempID
 	}
 	return nil
 }
 
 // IDLt is the resolver for the idLT field.
-func (r *roleWhereInputResolver) IDLt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *accessPolicyWhereInputResolver) IDLt(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDLT = &tempID
 	}
 	return nil
 }
 
 // IDLte is the resolver for the idLTE field.
-func (r *roleWhereInputResolver) IDLte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *accessPolicyWhereInputResolver) IDLte(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDLTE = &tempID
 	}
 	return nil
 }
 
 // CreatedBy is the resolver for the createdBy field.
-func (r *roleWhereInputResolver) CreatedBy(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *accessPolicyWhereInputResolver) CreatedBy(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedBy = &tempID
+	}
+	return nil
+}
+
+// CreatedByNeq is the resolver for the createdByNEQ field.
+func (r *accessPolicyWhereInputResolver) CreatedByNeq(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByNEQ = &tempID
+	}
+	return nil
+}
+
+// CreatedByIn is the resolver for the createdByIn field.
+func (r *accessPolicyWhereInputResolver) CreatedByIn(ctx context.Context, obj *ent.AccessPolicyWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByIn = append(obj.CreatedByIn, tempID)
+	}
+	return nil
+}
+
+// CreatedByNotIn is the resolver for the createdByNotIn field.
+func (r *accessPolicyWhereInputResolver) CreatedByNotIn(ctx context.Context, obj *ent.AccessPolicyWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByNotIn = append(obj.CreatedByNotIn, tempID)
+	}
+	return nil
+}
+
+// CreatedByGt is the resolver for the createdByGT field.
+func (r *accessPolicyWhereInputResolver) CreatedByGt(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGT = &tempID
+	}
+	return nil
+}
+
+// CreatedByGte is the resolver for the createdByGTE field.
+func (r *accessPolicyWhereInputResolver) CreatedByGte(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGTE = &tempID
+	}
+	return nil
+}
+
+// CreatedByLt is the resolver for the createdByLT field.
+func (r *accessPolicyWhereInputResolver) CreatedByLt(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLT = &tempID
+	}
+	return nil
+}
+
+// CreatedByLte is the resolver for the createdByLTE field.
+func (r *accessPolicyWhereInputResolver) CreatedByLte(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLTE = &tempID
+	}
+	return nil
+}
+
+// UpdatedBy is the resolver for the updatedBy field.
+func (r *accessPolicyWhereInputResolver) UpdatedBy(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedBy = &tempID
+	}
+	return nil
+}
+
+// UpdatedByNeq is the resolver for the updatedByNEQ field.
+func (r *accessPolicyWhereInputResolver) UpdatedByNeq(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByNEQ = &tempID
+	}
+	return nil
+}
+
+// UpdatedByIn is the resolver for the updatedByIn field.
+func (r *accessPolicyWhereInputResolver) UpdatedByIn(ctx context.Context, obj *ent.AccessPolicyWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByIn = append(obj.UpdatedByIn, tempID)
+	}
+	return nil
+}
+
+// UpdatedByNotIn is the resolver for the updatedByNotIn field.
+func (r *accessPolicyWhereInputResolver) UpdatedByNotIn(ctx context.Context, obj *ent.AccessPolicyWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByNotIn = append(obj.UpdatedByNotIn, tempID)
+	}
+	return nil
+}
+
+// UpdatedByGt is the resolver for the updatedByGT field.
+func (r *accessPolicyWhereInputResolver) UpdatedByGt(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGT = &tempID
+	}
+	return nil
+}
+
+// UpdatedByGte is the resolver for the updatedByGTE field.
+func (r *accessPolicyWhereInputResolver) UpdatedByGte(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGTE = &tempID
+	}
+	return nil
+}
+
+// UpdatedByLt is the resolver for the updatedByLT field.
+func (r *accessPolicyWhereInputResolver) UpdatedByLt(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLT = &tempID
+	}
+	return nil
+}
+
+// UpdatedByLte is the resolver for the updatedByLTE field.
+func (r *accessPolicyWhereInputResolver) UpdatedByLte(ctx context.Context, obj *ent.AccessPolicyWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLTE = &tempID
+	}
+	return nil
+}
+
+// ID is the resolver for the id field.
+func (r *auditEventWhereInputResolver) ID(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ID = &tempID
+	}
+	return nil
+}
+
+// IDNeq is the resolver for the idNEQ field.
+func (r *auditEventWhereInputResolver) IDNeq(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDNEQ = &tempID
+	}
+	return nil
+}
+
+// IDIn is the resolver for the idIn field.
+func (r *auditEventWhereInputResolver) IDIn(ctx context.Context, obj *ent.AuditEventWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDIn = append(obj.IDIn, tempID)
+	}
+	return nil
+}
+
+// IDNotIn is the resolver for the idNotIn field.
+func (r *auditEventWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.AuditEventWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDNotIn = append(obj.IDNotIn, tempID)
+	}
+	return nil
+}
+
+// IDGt is the resolver for the idGT field.
+func (r *auditEventWhereInputResolver) IDGt(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDGT = &tempID
+	}
+	return nil
+}
+
+// IDGte is the resolver for the idGTE field.
+func (r *auditEventWhereInputResolver) IDGte(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDGTE = &tempID
+	}
+	return nil
+}
+
+// IDLt is the resolver for the idLT field.
+func (r *auditEventWhereInputResolver) IDLt(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDLT = &tempID
+	}
+	return nil
+}
+
+// IDLte is the resolver for the idLTE field.
+func (r *auditEventWhereInputResolver) IDLte(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDLTE = &tempID
+	}
+	return nil
+}
+
+// ActorID is the resolver for the actorID field.
+func (r *auditEventWhereInputResolver) ActorID(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ActorID = &tempID
+	}
+	return nil
+}
+
+// ActorIDNeq is the resolver for the actorIDNEQ field.
+func (r *auditEventWhereInputResolver) ActorIDNeq(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ActorIDNEQ = &tempID
+	}
+	return nil
+}
+
+// ActorIDIn is the resolver for the actorIDIn field.
+func (r *auditEventWhereInputResolver) ActorIDIn(ctx context.Context, obj *ent.AuditEventWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.ActorIDIn = append(obj.ActorIDIn, tempID)
+	}
+	return nil
+}
+
+// ActorIDNotIn is the resolver for the actorIDNotIn field.
+func (r *auditEventWhereInputResolver) ActorIDNotIn(ctx context.Context, obj *ent.AuditEventWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.ActorIDNotIn = append(obj.ActorIDNotIn, tempID)
+	}
+	return nil
+}
+
+// ActorIDGt is the resolver for the actorIDGT field.
+func (r *auditEventWhereInputResolver) ActorIDGt(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ActorIDGT = &tempID
+	}
+	return nil
+}
+
+// ActorIDGte is the resolver for the actorIDGTE field.
+func (r *auditEventWhereInputResolver) ActorIDGte(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ActorIDGTE = &tempID
+	}
+	return nil
+}
+
+// ActorIDLt is the resolver for the actorIDLT field.
+func (r *auditEventWhereInputResolver) ActorIDLt(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ActorIDLT = &tempID
+	}
+	return nil
+}
+
+// ActorIDLte is the resolver for the actorIDLTE field.
+func (r *auditEventWhereInputResolver) ActorIDLte(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ActorIDLTE = &tempID
+	}
+	return nil
+}
+
+// EntityID is the resolver for the entityID field.
+func (r *auditEventWhereInputResolver) EntityID(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EntityID = &tempID
+	}
+	return nil
+}
+
+// EntityIDNeq is the resolver for the entityIDNEQ field.
+func (r *auditEventWhereInputResolver) EntityIDNeq(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EntityIDNEQ = &tempID
+	}
+	return nil
+}
+
+// EntityIDIn is the resolver for the entityIDIn field.
+func (r *auditEventWhereInputResolver) EntityIDIn(ctx context.Context, obj *ent.AuditEventWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.EntityIDIn = append(obj.EntityIDIn, tempID)
+	}
+	return nil
+}
+
+// EntityIDNotIn is the resolver for the entityIDNotIn field.
+func (r *auditEventWhereInputResolver) EntityIDNotIn(ctx context.Context, obj *ent.AuditEventWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.EntityIDNotIn = append(obj.EntityIDNotIn, tempID)
+	}
+	return nil
+}
+
+// EntityIDGt is the resolver for the entityIDGT field.
+func (r *auditEventWhereInputResolver) EntityIDGt(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EntityIDGT = &tempID
+	}
+	return nil
+}
+
+// EntityIDGte is the resolver for the entityIDGTE field.
+func (r *auditEventWhereInputResolver) EntityIDGte(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EntityIDGTE = &tempID
+	}
+	return nil
+}
+
+// EntityIDLt is the resolver for the entityIDLT field.
+func (r *auditEventWhereInputResolver) EntityIDLt(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EntityIDLT = &tempID
+	}
+	return nil
+}
+
+// EntityIDLte is the resolver for the entityIDLTE field.
+func (r *auditEventWhereInputResolver) EntityIDLte(ctx context.Context, obj *ent.AuditEventWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EntityIDLTE = &tempID
+	}
+	return nil
+}
+
+// UserIDs is the resolver for the userIDs field.
+func (r *createRoleInputResolver) UserIDs(ctx context.Context, obj *ent.CreateRoleInput, data []string) error {
+	for _, v := range data {
+		obj.UserIDs = append(obj.UserIDs, tools.StringToInt64(v))
+	}
+	return nil
+}
+
+// RoleIDs is the resolver for the roleIDs field.
+func (r *createUserInputResolver) RoleIDs(ctx context.Context, obj *ent.CreateUserInput, data []string) error {
+	for _, v := range data {
+		obj.RoleIDs = append(obj.RoleIDs, tools.StringToInt64(v))
+	}
+	return nil
+}
+
+// ID is the resolver for the id field.
+func (r *roleWhereInputResolver) ID(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ID = &tempID
+	}
+	return nil
+}
+
+// IDNeq is the resolver for the idNEQ field.
+func (r *roleWhereInputResolver) IDNeq(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDNEQ = &tempID
+	}
+	return nil
+}
+
+// IDIn is the resolver for the idIn field.
+func (r *roleWhereInputResolver) IDIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDIn = append(obj.IDIn, tempID)
+	}
+	return nil
+}
+
+// IDNotIn is the resolver for the idNotIn field.
+func (r *roleWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDNotIn = append(obj.IDNotIn, tempID)
+	}
+	return nil
+}
+
+// IDGt is the resolver for the idGT field.
+func (r *roleWhereInputResolver) IDGt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDGT = &tempID
+	}
+	return nil
+}
+
+// IDGte is the resolver for the idGTE field.
+func (r *roleWhereInputResolver) IDGte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDGTE = &tempID
+	}
+	return nil
+}
+
+// IDLt is the resolver for the idLT field.
+func (r *roleWhereInputResolver) IDLt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDLT = &tempID
+	}
+	return nil
+}
+
+// IDLte is the resolver for the idLTE field.
+func (r *roleWhereInputResolver) IDLte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDLTE = &tempID
+	}
+	return nil
+}
+
+// CreatedBy is the resolver for the createdBy field.
+func (r *roleWhereInputResolver) CreatedBy(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedBy = &tempID
+	}
+	return nil
+}
+
+// CreatedByNeq is the resolver for the createdByNEQ field.
+func (r *roleWhereInputResolver) CreatedByNeq(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByNEQ = &tempID
+	}
+	return nil
+}
+
+// CreatedByIn is the resolver for the createdByIn field.
+func (r *roleWhereInputResolver) CreatedByIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByIn = append(obj.CreatedByIn, tempID)
+	}
+	return nil
+}
+
+// CreatedByNotIn is the resolver for the createdByNotIn field.
+func (r *roleWhereInputResolver) CreatedByNotIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByNotIn = append(obj.CreatedByNotIn, tempID)
+	}
+	return nil
+}
+
+// CreatedByGt is the resolver for the createdByGT field.
+func (r *roleWhereInputResolver) CreatedByGt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGT = &tempID
+	}
+	return nil
+}
+
+// CreatedByGte is the resolver for the createdByGTE field.
+func (r *roleWhereInputResolver) CreatedByGte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGTE = &tempID
+	}
+	return nil
+}
+
+// CreatedByLt is the resolver for the createdByLT field.
+func (r *roleWhereInputResolver) CreatedByLt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLT = &tempID
+	}
+	return nil
+}
+
+// CreatedByLte is the resolver for the createdByLTE field.
+func (r *roleWhereInputResolver) CreatedByLte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLTE = &tempID
+	}
+	return nil
+}
+
+// UpdatedBy is the resolver for the updatedBy field.
+func (r *roleWhereInputResolver) UpdatedBy(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedBy = &tempID
+	}
+	return nil
+}
+
+// UpdatedByNeq is the resolver for the updatedByNEQ field.
+func (r *roleWhereInputResolver) UpdatedByNeq(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByNEQ = &tempID
+	}
+	return nil
+}
+
+// UpdatedByIn is the resolver for the updatedByIn field.
+func (r *roleWhereInputResolver) UpdatedByIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByIn = append(obj.UpdatedByIn, tempID)
+	}
+	return nil
+}
+
+// UpdatedByNotIn is the resolver for the updatedByNotIn field.
+func (r *roleWhereInputResolver) UpdatedByNotIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByNotIn = append(obj.UpdatedByNotIn, tempID)
+	}
+	return nil
+}
+
+// UpdatedByGt is the resolver for the updatedByGT field.
+func (r *roleWhereInputResolver) UpdatedByGt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGT = &tempID
+	}
+	return nil
+}
+
+// UpdatedByGte is the resolver for the updatedByGTE field.
+func (r *roleWhereInputResolver) UpdatedByGte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGTE = &tempID
+	}
+	return nil
+}
+
+// UpdatedByLt is the resolver for the updatedByLT field.
+func (r *roleWhereInputResolver) UpdatedByLt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLT = &tempID
+	}
+	return nil
+}
+
+// UpdatedByLte is the resolver for the updatedByLTE field.
+func (r *roleWhereInputResolver) UpdatedByLte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLTE = &tempID
+	}
+	return nil
+}
+
+// AddUserIDs is the resolver for the addUserIDs field.
+func (r *updateRoleInputResolver) AddUserIDs(ctx context.Context, obj *ent.UpdateRoleInput, data []string) error {
+	for _, v := range data {
+		obj.AddUserIDs = append(obj.AddUserIDs, tools.StringToInt64(v))
+	}
+	return nil
+}
+
+// RemoveUserIDs is the resolver for the removeUserIDs field.
+func (r *updateRoleInputResolver) RemoveUserIDs(ctx context.Context, obj *ent.UpdateRoleInput, data []string) error {
+	for _, v := range data {
+		obj.RemoveUserIDs = append(obj.RemoveUserIDs, tools.StringToInt64(v))
+	}
+	return nil
+}
+
+// AddRoleIDs is the resolver for the addRoleIDs field.
+func (r *updateUserInputResolver) AddRoleIDs(ctx context.Context, obj *ent.UpdateUserInput, data []string) error {
+	for _, v := range data {
+		obj.AddRoleIDs = append(obj.AddRoleIDs, tools.StringToInt64(v))
+	}
+	return nil
+}
+
+// RemoveRoleIDs is the resolver for the removeRoleIDs field.
+func (r *updateUserInputResolver) RemoveRoleIDs(ctx context.Context, obj *ent.UpdateUserInput, data []string) error {
+	for _, v := range data {
+		obj.RemoveRoleIDs = append(obj.RemoveRoleIDs, tools.StringToInt64(v))
+	}
+	return nil
+}
+
+// ID is the resolver for the id field.
+func (r *userRoleWhereInputResolver) ID(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ID = &tempID
+	}
+	return nil
+}
+
+// IDNeq is the resolver for the idNEQ field.
+func (r *userRoleWhereInputResolver) IDNeq(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDNEQ = &tempID
+	}
+	return nil
+}
+
+// IDIn is the resolver for the idIn field.
+func (r *userRoleWhereInputResolver) IDIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDIn = append(obj.IDIn, tempID)
+	}
+	return nil
+}
+
+// IDNotIn is the resolver for the idNotIn field.
+func (r *userRoleWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDNotIn = append(obj.IDNotIn, tempID)
+	}
+	return nil
+}
+
+// IDGt is the resolver for the idGT field.
+func (r *userRoleWhereInputResolver) IDGt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDGT = &tempID
+	}
+	return nil
+}
+
+// IDGte is the resolver for the idGTE field.
+func (r *userRoleWhereInputResolver) IDGte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDGTE = &tempID
+	}
+	return nil
+}
+
+// IDLt is the resolver for the idLT field.
+func (r *userRoleWhereInputResolver) IDLt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDLT = &tempID
+	}
+	return nil
+}
+
+// IDLte is the resolver for the idLTE field.
+func (r *userRoleWhereInputResolver) IDLte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDLTE = &tempID
+	}
+	return nil
+}
+
+// CreatedBy is the resolver for the createdBy field.
+func (r *userRoleWhereInputResolver) CreatedBy(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.CreatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
 }
 
 // CreatedByNeq is the resolver for the createdByNEQ field.
-func (r *roleWhereInputResolver) CreatedByNeq(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *userRoleWhereInputResolver) CreatedByNeq(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.CreatedByNEQ = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
 }
 
 // CreatedByIn is the resolver for the createdByIn field.
-func (r *roleWhereInputResolver) CreatedByIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+func (r *userRoleWhereInputResolver) CreatedByIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
 	for _, v := range data {
-		obj.CreatedByIn = append(obj.CreatedByIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByIn = append(obj.CreatedByIn, tempID)
 	}
 	return nil
 }
 
 // CreatedByNotIn is the resolver for the createdByNotIn field.
-func (r *roleWhereInputResolver) CreatedByNotIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+func (r *userRoleWhereInputResolver) CreatedByNotIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
 	for _, v := range data {
-		obj.CreatedByNotIn = append(obj.CreatedByNotIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByNotIn = append(obj.CreatedByNotIn, tempID)
 	}
 	return nil
 }
 
 // CreatedByGt is the resolver for the createdByGT field.
-func (r *roleWhereInputResolver) CreatedByGt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByGt - createdByGT"))
+func (r *userRoleWhereInputResolver) CreatedByGt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGT = &tempID
+	}
+	return nil
 }
 
 // CreatedByGte is the resolver for the createdByGTE field.
-func (r *roleWhereInputResolver) CreatedByGte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByGte - createdByGTE"))
+func (r *userRoleWhereInputResolver) CreatedByGte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGTE = &tempID
+	}
+	return nil
 }
 
 // CreatedByLt is the resolver for the createdByLT field.
-func (r *roleWhereInputResolver) CreatedByLt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByLt - createdByLT"))
+func (r *userRoleWhereInputResolver) CreatedByLt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLT = &tempID
+	}
+	return nil
 }
 
 // CreatedByLte is the resolver for the createdByLTE field.
-func (r *roleWhereInputResolver) CreatedByLte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByLte - createdByLTE"))
+func (r *userRoleWhereInputResolver) CreatedByLte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLTE = &tempID
+	}
+	return nil
 }
 
 // UpdatedBy is the resolver for the updatedBy field.
-func (r *roleWhereInputResolver) UpdatedBy(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *userRoleWhereInputResolver) UpdatedBy(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.UpdatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
 }
 
 // UpdatedByNeq is the resolver for the updatedByNEQ field.
-func (r *roleWhereInputResolver) UpdatedByNeq(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
+func (r *userRoleWhereInputResolver) UpdatedByNeq(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.UpdatedByNEQ = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
 }
 
 // UpdatedByIn is the resolver for the updatedByIn field.
-func (r *roleWhereInputResolver) UpdatedByIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+func (r *userRoleWhereInputResolver) UpdatedByIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
 	for _, v := range data {
-		obj.UpdatedByIn = append(obj.UpdatedByIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByIn = append(obj.UpdatedByIn, tempID)
 	}
 	return nil
 }
 
 // UpdatedByNotIn is the resolver for the updatedByNotIn field.
-func (r *roleWhereInputResolver) UpdatedByNotIn(ctx context.Context, obj *ent.RoleWhereInput, data []string) error {
+func (r *userRoleWhereInputResolver) UpdatedByNotIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
 	for _, v := range data {
-		obj.UpdatedByNotIn = append(obj.UpdatedByNotIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByNotIn = append(obj.UpdatedByNotIn, tempID)
 	}
 	return nil
 }
 
 // UpdatedByGt is the resolver for the updatedByGT field.
-func (r *roleWhereInputResolver) UpdatedByGt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByGt - updatedByGT"))
+func (r *userRoleWhereInputResolver) UpdatedByGt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGT = &tempID
+	}
+	return nil
 }
 
 // UpdatedByGte is the resolver for the updatedByGTE field.
-func (r *roleWhereInputResolver) UpdatedByGte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByGte - updatedByGTE"))
+func (r *userRoleWhereInputResolver) UpdatedByGte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGTE = &tempID
+	}
+	return nil
 }
 
 // UpdatedByLt is the resolver for the updatedByLT field.
-func (r *roleWhereInputResolver) UpdatedByLt(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByLt - updatedByLT"))
+func (r *userRoleWhereInputResolver) UpdatedByLt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLT = &tempID
+	}
+	return nil
 }
 
 // UpdatedByLte is the resolver for the updatedByLTE field.
-func (r *roleWhereInputResolver) UpdatedByLte(ctx context.Context, obj *ent.RoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByLte - updatedByLTE"))
+func (r *userRoleWhereInputResolver) UpdatedByLte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLTE = &tempID
+	}
+	return nil
+}
+
+// ID is the resolver for the id field.
+func (r *userWhereInputResolver) ID(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.ID = &tempID
+	}
+	return nil
+}
+
+// IDNeq is the resolver for the idNEQ field.
+func (r *userWhereInputResolver) IDNeq(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDNEQ = &tempID
+	}
+	return nil
+}
+
+// IDIn is the resolver for the idIn field.
+func (r *userWhereInputResolver) IDIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDIn = append(obj.IDIn, tempID)
+	}
+	return nil
+}
+
+// IDNotIn is the resolver for the idNotIn field.
+func (r *userWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDNotIn = append(obj.IDNotIn, tempID)
+	}
+	return nil
+}
+
+// IDGt is the resolver for the idGT field.
+func (r *userWhereInputResolver) IDGt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDGT = &tempID
+	}
+	return nil
+}
+
+// IDGte is the resolver for the idGTE field.
+func (r *userWhereInputResolver) IDGte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDGTE = &tempID
+	}
+	return nil
+}
+
+// IDLt is the resolver for the idLT field.
+func (r *userWhereInputResolver) IDLt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDLT = &tempID
+	}
+	return nil
+}
+
+// IDLte is the resolver for the idLTE field.
+func (r *userWhereInputResolver) IDLte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.IDLTE = &tempID
+	}
+	return nil
 }
 
 // CreatedBy is the resolver for the createdBy field.
-func (r *updateRoleInputResolver) CreatedBy(ctx context.Context, obj *ent.UpdateRoleInput, data *string) error {
+func (r *userWhereInputResolver) CreatedBy(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.CreatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
+}
+
+// CreatedByNeq is the resolver for the createdByNEQ field.
+func (r *userWhereInputResolver) CreatedByNeq(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByNEQ = &tempID
+	}
+	return nil
+}
+
+// CreatedByIn is the resolver for the createdByIn field.
+func (r *userWhereInputResolver) CreatedByIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByIn = append(obj.CreatedByIn, tempID)
+	}
+	return nil
+}
+
+// CreatedByNotIn is the resolver for the createdByNotIn field.
+func (r *userWhereInputResolver) CreatedByNotIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+	for _, v := range data {
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByNotIn = append(obj.CreatedByNotIn, tempID)
+	}
+	return nil
+}
+
+// CreatedByGt is the resolver for the createdByGT field.
+func (r *userWhereInputResolver) CreatedByGt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGT = &tempID
+	}
+	return nil
+}
+
+// CreatedByGte is the resolver for the createdByGTE field.
+func (r *userWhereInputResolver) CreatedByGte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGTE = &tempID
+	}
+	return nil
+}
+
+// CreatedByLt is the resolver for the createdByLT field.
+func (r *userWhereInputResolver) CreatedByLt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLT = &tempID
+	}
+	return nil
+}
+
+// CreatedByLte is the resolver for the createdByLTE field.
+func (r *userWhereInputResolver) CreatedByLte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLTE = &tempID
+	}
+	return nil
 }
 
 // UpdatedBy is the resolver for the updatedBy field.
-func (r *updateRoleInputResolver) UpdatedBy(ctx context.Context, obj *ent.UpdateRoleInput, data *string) error {
+func (r *userWhereInputResolver) UpdatedBy(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.UpdatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
 }
 
-// AddUserIDs is the resolver for the addUserIDs field.
-func (r *updateRoleInputResolver) AddUserIDs(ctx context.Context, obj *ent.UpdateRoleInput, data []string) error {
+// UpdatedByNeq is the resolver for the updatedByNEQ field.
+func (r *userWhereInputResolver) UpdatedByNeq(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByNEQ = &tempID
+	}
+	return nil
+}
+
+// UpdatedByIn is the resolver for the updatedByIn field.
+func (r *userWhereInputResolver) UpdatedByIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
 	for _, v := range data {
-		obj.AddUserIDs = append(obj.AddUserIDs, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByIn = append(obj.UpdatedByIn, tempID)
 	}
 	return nil
 }
 
-// RemoveUserIDs is the resolver for the removeUserIDs field.
-func (r *updateRoleInputResolver) RemoveUserIDs(ctx context.Context, obj *ent.UpdateRoleInput, data []string) error {
+// UpdatedByNotIn is the resolver for the updatedByNotIn field.
+func (r *userWhereInputResolver) UpdatedByNotIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
 	for _, v := range data {
-		obj.RemoveUserIDs = append(obj.RemoveUserIDs, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByNotIn = append(obj.UpdatedByNotIn, tempID)
 	}
 	return nil
 }
 
-// CreatedBy is the resolver for the createdBy field.
-func (r *updateUserInputResolver) CreatedBy(ctx context.Context, obj *ent.UpdateUserInput, data *string) error {
+// UpdatedByGt is the resolver for the updatedByGT field.
+func (r *userWhereInputResolver) UpdatedByGt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.CreatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGT = &tempID
 	}
+	return nil
 }
 
-// UpdatedBy is the resolver for the updatedBy field.
-func (r *updateUserInputResolver) UpdatedBy(ctx context.Context, obj *ent.UpdateUserInput, data *string) error {
+// UpdatedByGte is the resolver for the updatedByGTE field.
+func (r *userWhereInputResolver) UpdatedByGte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.UpdatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGTE = &tempID
 	}
+	return nil
 }
 
-// AddRoleIDs is the resolver for the addRoleIDs field.
-func (r *updateUserInputResolver) AddRoleIDs(ctx context.Context, obj *ent.UpdateUserInput, data []string) error {
-	for _, v := range data {
-		obj.AddRoleIDs = append(obj.AddRoleIDs, tools.StringToInt64(v))
+// UpdatedByLt is the resolver for the updatedByLT field.
+func (r *userWhereInputResolver) UpdatedByLt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLT = &tempID
 	}
 	return nil
 }
 
-// RemoveRoleIDs is the resolver for the removeRoleIDs field.
-func (r *updateUserInputResolver) RemoveRoleIDs(ctx context.Context, obj *ent.UpdateUserInput, data []string) error {
-	for _, v := range data {
-		obj.RemoveRoleIDs = append(obj.RemoveRoleIDs, tools.StringToInt64(v))
+// UpdatedByLte is the resolver for the updatedByLTE field.
+func (r *userWhereInputResolver) UpdatedByLte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLTE = &tempID
 	}
 	return nil
 }
 
 // ID is the resolver for the id field.
-func (r *userRoleWhereInputResolver) ID(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+func (r *webhookDeliveryWhereInputResolver) ID(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.ID = &tempID
 	}
 	return nil
 }
 
 // IDNeq is the resolver for the idNEQ field.
-func (r *userRoleWhereInputResolver) IDNeq(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+func (r *webhookDeliveryWhereInputResolver) IDNeq(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDNEQ = &tempID
 	}
 	return nil
 }
 
 // IDIn is the resolver for the idIn field.
-func (r *userRoleWhereInputResolver) IDIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
+func (r *webhookDeliveryWhereInputResolver) IDIn(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data []string) error {
 	for _, v := range data {
-		obj.IDIn = append(obj.IDIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDIn = append(obj.IDIn, tempID)
 	}
 	return nil
 }
 
 // IDNotIn is the resolver for the idNotIn field.
-func (r *userRoleWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
+func (r *webhookDeliveryWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data []string) error {
 	for _, v := range data {
-		obj.IDNotIn = append(obj.IDNotIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDNotIn = append(obj.IDNotIn, tempID)
 	}
 	return nil
 }
 
 // IDGt is the resolver for the idGT field.
-func (r *userRoleWhereInputResolver) IDGt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+func (r *webhookDeliveryWhereInputResolver) IDGt(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDGT = &tempID
 	}
 	return nil
 }
 
 // IDGte is the resolver for the idGTE field.
-func (r *userRoleWhereInputResolver) IDGte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+func (r *webhookDeliveryWhereInputResolver) IDGte(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDGTE = &tempID
 	}
 	return nil
 }
 
 // IDLt is the resolver for the idLT field.
-func (r *userRoleWhereInputResolver) IDLt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+func (r *webhookDeliveryWhereInputResolver) IDLt(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDLT = &tempID
 	}
 	return nil
 }
 
 // IDLte is the resolver for the idLTE field.
-func (r *userRoleWhereInputResolver) IDLte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+func (r *webhookDeliveryWhereInputResolver) IDLte(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDLTE = &tempID
 	}
 	return nil
 }
 
-// CreatedBy is the resolver for the createdBy field.
-func (r *userRoleWhereInputResolver) CreatedBy(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+// SubscriptionID is the resolver for the subscriptionID field.
+func (r *webhookDeliveryWhereInputResolver) SubscriptionID(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.CreatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.SubscriptionID = &tempID
 	}
+	return nil
 }
 
-// CreatedByNeq is the resolver for the createdByNEQ field.
-func (r *userRoleWhereInputResolver) CreatedByNeq(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+// SubscriptionIDNeq is the resolver for the subscriptionIDNEQ field.
+func (r *webhookDeliveryWhereInputResolver) SubscriptionIDNeq(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.CreatedByNEQ = &tempID
-		return nil
-	} else {
-		return errors.New("null")
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.SubscriptionIDNEQ = &tempID
 	}
+	return nil
 }
 
-// CreatedByIn is the resolver for the createdByIn field.
-func (r *userRoleWhereInputResolver) CreatedByIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
+// SubscriptionIDIn is the resolver for the subscriptionIDIn field.
+func (r *webhookDeliveryWhereInputResolver) SubscriptionIDIn(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data []string) error {
 	for _, v := range data {
-		obj.CreatedByIn = append(obj.CreatedByIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.SubscriptionIDIn = append(obj.SubscriptionIDIn, tempID)
 	}
 	return nil
 }
 
-// CreatedByNotIn is the resolver for the createdByNotIn field.
-func (r *userRoleWhereInputResolver) CreatedByNotIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
+// SubscriptionIDNotIn is the resolver for the subscriptionIDNotIn field.
+func (r *webhookDeliveryWhereInputResolver) SubscriptionIDNotIn(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data []string) error {
 	for _, v := range data {
-		obj.CreatedByNotIn = append(obj.CreatedByNotIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.SubscriptionIDNotIn = append(obj.SubscriptionIDNotIn, tempID)
 	}
 	return nil
 }
 
-// CreatedByGt is the resolver for the createdByGT field.
-func (r *userRoleWhereInputResolver) CreatedByGt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByGt - createdByGT"))
-}
-
-// CreatedByGte is the resolver for the createdByGTE field.
-func (r *userRoleWhereInputResolver) CreatedByGte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByGte - createdByGTE"))
-}
-
-// CreatedByLt is the resolver for the createdByLT field.
-func (r *userRoleWhereInputResolver) CreatedByLt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByLt - createdByLT"))
-}
-
-// CreatedByLte is the resolver for the createdByLTE field.
-func (r *userRoleWhereInputResolver) CreatedByLte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByLte - createdByLTE"))
-}
-
-// UpdatedBy is the resolver for the updatedBy field.
-func (r *userRoleWhereInputResolver) UpdatedBy(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+// EventID is the resolver for the eventID field.
+func (r *webhookDeliveryWhereInputResolver) EventID(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.UpdatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EventID = &tempID
 	}
+	return nil
 }
 
-// UpdatedByNeq is the resolver for the updatedByNEQ field.
-func (r *userRoleWhereInputResolver) UpdatedByNeq(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
+// EventIDNeq is the resolver for the eventIDNEQ field.
+func (r *webhookDeliveryWhereInputResolver) EventIDNeq(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
-		obj.UpdatedByNEQ = &tempID
-		return nil
-	} else {
-		return errors.New("null")
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EventIDNEQ = &tempID
 	}
+	return nil
 }
 
-// UpdatedByIn is the resolver for the updatedByIn field.
-func (r *userRoleWhereInputResolver) UpdatedByIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
+// EventIDIn is the resolver for the eventIDIn field.
+func (r *webhookDeliveryWhereInputResolver) EventIDIn(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data []string) error {
 	for _, v := range data {
-		obj.UpdatedByIn = append(obj.UpdatedByIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.EventIDIn = append(obj.EventIDIn, tempID)
 	}
 	return nil
 }
 
-// UpdatedByNotIn is the resolver for the updatedByNotIn field.
-func (r *userRoleWhereInputResolver) UpdatedByNotIn(ctx context.Context, obj *ent.UserRoleWhereInput, data []string) error {
+// EventIDNotIn is the resolver for the eventIDNotIn field.
+func (r *webhookDeliveryWhereInputResolver) EventIDNotIn(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data []string) error {
 	for _, v := range data {
-		obj.UpdatedByNotIn = append(obj.UpdatedByNotIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.EventIDNotIn = append(obj.EventIDNotIn, tempID)
 	}
 	return nil
 }
 
-// UpdatedByGt is the resolver for the updatedByGT field.
-func (r *userRoleWhereInputResolver) UpdatedByGt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByGt - updatedByGT"))
+// EventIDGt is the resolver for the eventIDGT field.
+func (r *webhookDeliveryWhereInputResolver) EventIDGt(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EventIDGT = &tempID
+	}
+	return nil
 }
 
-// UpdatedByGte is the resolver for the updatedByGTE field.
-func (r *userRoleWhereInputResolver) UpdatedByGte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByGte - updatedByGTE"))
+// EventIDGte is the resolver for the eventIDGTE field.
+func (r *webhookDeliveryWhereInputResolver) EventIDGte(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EventIDGTE = &tempID
+	}
+	return nil
 }
 
-// UpdatedByLt is the resolver for the updatedByLT field.
-func (r *userRoleWhereInputResolver) UpdatedByLt(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByLt - updatedByLT"))
+// EventIDLt is the resolver for the eventIDLT field.
+func (r *webhookDeliveryWhereInputResolver) EventIDLt(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EventIDLT = &tempID
+	}
+	return nil
 }
 
-// UpdatedByLte is the resolver for the updatedByLTE field.
-func (r *userRoleWhereInputResolver) UpdatedByLte(ctx context.Context, obj *ent.UserRoleWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByLte - updatedByLTE"))
+// EventIDLte is the resolver for the eventIDLTE field.
+func (r *webhookDeliveryWhereInputResolver) EventIDLte(ctx context.Context, obj *ent.WebhookDeliveryWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.EventIDLTE = &tempID
+	}
+	return nil
 }
 
 // ID is the resolver for the id field.
-func (r *userWhereInputResolver) ID(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) ID(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.ID = &tempID
 	}
 	return nil
 }
 
 // IDNeq is the resolver for the idNEQ field.
-func (r *userWhereInputResolver) IDNeq(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) IDNeq(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDNEQ = &tempID
 	}
 	return nil
 }
 
 // IDIn is the resolver for the idIn field.
-func (r *userWhereInputResolver) IDIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+func (r *webhookSubscriptionWhereInputResolver) IDIn(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data []string) error {
 	for _, v := range data {
-		obj.IDIn = append(obj.IDIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDIn = append(obj.IDIn, tempID)
 	}
 	return nil
 }
 
 // IDNotIn is the resolver for the idNotIn field.
-func (r *userWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+func (r *webhookSubscriptionWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data []string) error {
 	for _, v := range data {
-		obj.IDNotIn = append(obj.IDNotIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.IDNotIn = append(obj.IDNotIn, tempID)
 	}
 	return nil
 }
 
 // IDGt is the resolver for the idGT field.
-func (r *userWhereInputResolver) IDGt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) IDGt(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDGT = &tempID
 	}
 	return nil
 }
 
 // IDGte is the resolver for the idGTE field.
-func (r *userWhereInputResolver) IDGte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) IDGte(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDGTE = &tempID
 	}
 	return nil
 }
 
 // IDLt is the resolver for the idLT field.
-func (r *userWhereInputResolver) IDLt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) IDLt(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDLT = &tempID
 	}
 	return nil
 }
 
 // IDLte is the resolver for the idLTE field.
-func (r *userWhereInputResolver) IDLte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) IDLte(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.IDLTE = &tempID
 	}
 	return nil
 }
 
 // CreatedBy is the resolver for the createdBy field.
-func (r *userWhereInputResolver) CreatedBy(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) CreatedBy(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.CreatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
 }
 
 // CreatedByNeq is the resolver for the createdByNEQ field.
-func (r *userWhereInputResolver) CreatedByNeq(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) CreatedByNeq(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.CreatedByNEQ = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
 }
 
 // CreatedByIn is the resolver for the createdByIn field.
-func (r *userWhereInputResolver) CreatedByIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+func (r *webhookSubscriptionWhereInputResolver) CreatedByIn(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data []string) error {
 	for _, v := range data {
-		obj.CreatedByIn = append(obj.CreatedByIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByIn = append(obj.CreatedByIn, tempID)
 	}
 	return nil
 }
 
 // CreatedByNotIn is the resolver for the createdByNotIn field.
-func (r *userWhereInputResolver) CreatedByNotIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+func (r *webhookSubscriptionWhereInputResolver) CreatedByNotIn(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data []string) error {
 	for _, v := range data {
-		obj.CreatedByNotIn = append(obj.CreatedByNotIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByNotIn = append(obj.CreatedByNotIn, tempID)
 	}
 	return nil
 }
 
 // CreatedByGt is the resolver for the createdByGT field.
-func (r *userWhereInputResolver) CreatedByGt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByGt - createdByGT"))
+func (r *webhookSubscriptionWhereInputResolver) CreatedByGt(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGT = &tempID
+	}
+	return nil
 }
 
 // CreatedByGte is the resolver for the createdByGTE field.
-func (r *userWhereInputResolver) CreatedByGte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByGte - createdByGTE"))
+func (r *webhookSubscriptionWhereInputResolver) CreatedByGte(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByGTE = &tempID
+	}
+	return nil
 }
 
 // CreatedByLt is the resolver for the createdByLT field.
-func (r *userWhereInputResolver) CreatedByLt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByLt - createdByLT"))
+func (r *webhookSubscriptionWhereInputResolver) CreatedByLt(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLT = &tempID
+	}
+	return nil
 }
 
 // CreatedByLte is the resolver for the createdByLTE field.
-func (r *userWhereInputResolver) CreatedByLte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: CreatedByLte - createdByLTE"))
+func (r *webhookSubscriptionWhereInputResolver) CreatedByLte(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.CreatedByLTE = &tempID
+	}
+	return nil
 }
 
 // UpdatedBy is the resolver for the updatedBy field.
-func (r *userWhereInputResolver) UpdatedBy(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) UpdatedBy(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.UpdatedBy = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
 }
 
 // UpdatedByNeq is the resolver for the updatedByNEQ field.
-func (r *userWhereInputResolver) UpdatedByNeq(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
+func (r *webhookSubscriptionWhereInputResolver) UpdatedByNeq(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
 	if data != nil {
-		tempID := tools.StringToInt64(*data)
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
 		obj.UpdatedByNEQ = &tempID
-		return nil
-	} else {
-		return errors.New("null")
 	}
+	return nil
 }
 
 // UpdatedByIn is the resolver for the updatedByIn field.
-func (r *userWhereInputResolver) UpdatedByIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+func (r *webhookSubscriptionWhereInputResolver) UpdatedByIn(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data []string) error {
 	for _, v := range data {
-		obj.UpdatedByIn = append(obj.UpdatedByIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByIn = append(obj.UpdatedByIn, tempID)
 	}
 	return nil
 }
 
 // UpdatedByNotIn is the resolver for the updatedByNotIn field.
-func (r *userWhereInputResolver) UpdatedByNotIn(ctx context.Context, obj *ent.UserWhereInput, data []string) error {
+func (r *webhookSubscriptionWhereInputResolver) UpdatedByNotIn(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data []string) error {
 	for _, v := range data {
-		obj.UpdatedByNotIn = append(obj.UpdatedByNotIn, tools.StringToInt64(v))
+		tempID, err := parseID(v)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByNotIn = append(obj.UpdatedByNotIn, tempID)
 	}
 	return nil
 }
 
 // UpdatedByGt is the resolver for the updatedByGT field.
-func (r *userWhereInputResolver) UpdatedByGt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByGt - updatedByGT"))
+func (r *webhookSubscriptionWhereInputResolver) UpdatedByGt(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGT = &tempID
+	}
+	return nil
 }
 
 // UpdatedByGte is the resolver for the updatedByGTE field.
-func (r *userWhereInputResolver) UpdatedByGte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByGte - updatedByGTE"))
+func (r *webhookSubscriptionWhereInputResolver) UpdatedByGte(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByGTE = &tempID
+	}
+	return nil
 }
 
 // UpdatedByLt is the resolver for the updatedByLT field.
-func (r *userWhereInputResolver) UpdatedByLt(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByLt - updatedByLT"))
+func (r *webhookSubscriptionWhereInputResolver) UpdatedByLt(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLT = &tempID
+	}
+	return nil
 }
 
 // UpdatedByLte is the resolver for the updatedByLTE field.
-func (r *userWhereInputResolver) UpdatedByLte(ctx context.Context, obj *ent.UserWhereInput, data *string) error {
-	panic(fmt.Errorf("not implemented: UpdatedByLte - updatedByLTE"))
+func (r *webhookSubscriptionWhereInputResolver) UpdatedByLte(ctx context.Context, obj *ent.WebhookSubscriptionWhereInput, data *string) error {
+	if data != nil {
+		tempID, err := parseID(*data)
+		if err != nil {
+			return err
+		}
+		obj.UpdatedByLTE = &tempID
+	}
+	return nil
 }
 
+// AccessPolicy returns AccessPolicyResolver implementation.
+func (r *Resolver) AccessPolicy() AccessPolicyResolver { return &accessPolicyResolver{r} }
+
+// AuditEvent returns AuditEventResolver implementation.
+func (r *Resolver) AuditEvent() AuditEventResolver { return &auditEventResolver{r} }
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
+// OutboxEvent returns OutboxEventResolver implementation.
+func (r *Resolver) OutboxEvent() OutboxEventResolver { return &outboxEventResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
 // Role returns RoleResolver implementation.
 func (r *Resolver) Role() RoleResolver { return &roleResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
 // UserRole returns UserRoleResolver implementation.
 func (r *Resolver) UserRole() UserRoleResolver { return &userRoleResolver{r} }
 
+// WebhookDelivery returns WebhookDeliveryResolver implementation.
+func (r *Resolver) WebhookDelivery() WebhookDeliveryResolver { return &webhookDeliveryResolver{r} }
+
+// WebhookSubscription returns WebhookSubscriptionResolver implementation.
+func (r *Resolver) WebhookSubscription() WebhookSubscriptionResolver {
+	return &webhookSubscriptionResolver{r}
+}
+
+// AccessPolicyWhereInput returns AccessPolicyWhereInputResolver implementation.
+func (r *Resolver) AccessPolicyWhereInput() AccessPolicyWhereInputResolver {
+	return &accessPolicyWhereInputResolver{r}
+}
+
+// AuditEventWhereInput returns AuditEventWhereInputResolver implementation.
+func (r *Resolver) AuditEventWhereInput() AuditEventWhereInputResolver {
+	return &auditEventWhereInputResolver{r}
+}
+
 // CreateRoleInput returns CreateRoleInputResolver implementation.
 func (r *Resolver) CreateRoleInput() CreateRoleInputResolver { return &createRoleInputResolver{r} }
 
@@ -987,11 +2822,29 @@ func (r *Resolver) UserRoleWhereInput() UserRoleWhereInputResolver {
 // UserWhereInput returns UserWhereInputResolver implementation.
 func (r *Resolver) UserWhereInput() UserWhereInputResolver { return &userWhereInputResolver{r} }
 
+// WebhookDeliveryWhereInput returns WebhookDeliveryWhereInputResolver implementation.
+func (r *Resolver) WebhookDeliveryWhereInput() WebhookDeliveryWhereInputResolver {
+	return &webhookDeliveryWhereInputResolver{r}
+}
+
+// WebhookSubscriptionWhereInput returns WebhookSubscriptionWhereInputResolver implementation.
+func (r *Resolver) WebhookSubscriptionWhereInput() WebhookSubscriptionWhereInputResolver {
+	return &webhookSubscriptionWhereInputResolver{r}
+}
+
+type accessPolicyResolver struct{ *Resolver }
+type auditEventResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type outboxEventResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type roleResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type userRoleResolver struct{ *Resolver }
+type webhookDeliveryResolver struct{ *Resolver }
+type webhookSubscriptionResolver struct{ *Resolver }
+type accessPolicyWhereInputResolver struct{ *Resolver }
+type auditEventWhereInputResolver struct{ *Resolver }
 type createRoleInputResolver struct{ *Resolver }
 type createUserInputResolver struct{ *Resolver }
 type roleWhereInputResolver struct{ *Resolver }
@@ -999,3 +2852,5 @@ type updateRoleInputResolver struct{ *Resolver }
 type updateUserInputResolver struct{ *Resolver }
 type userRoleWhereInputResolver struct{ *Resolver }
 type userWhereInputResolver struct{ *Resolver }
+type webhookDeliveryWhereInputResolver struct{ *Resolver }
+type webhookSubscriptionWhereInputResolver struct{ *Resolver }
@@ -13,7 +13,7 @@ import (
 
 // FindUserByID is the resolver for the findUserByID field.
 func (r *entityResolver) FindUserByID(ctx context.Context, id string) (*ent.User, error) {
-	return r.client.User.Query().Where(user.ID(tools.StringToInt64(id)), user.DeletedAtEQ(tools.ZeroTime)).First(ctx)
+	return r.client.User.Query().Where(user.ID(tools.StringToInt64(id))).First(ctx)
 }
 
 // Entity returns EntityResolver implementation.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/gin-gonic/gin"
 	"github.com/stark-sim/cas/tools"
 	"net/http"
@@ -50,6 +51,9 @@ func NewAuthenticationMiddleware(skipAuthForDirectives ...string) DirectiveDrive
 	return DirectiveDrivenAuthenticator{SkipAuthFor: skipAuthForDirectives}
 }
 
+// introspectionFields 不需要登录的内省字段
+var introspectionFields = []string{"__schema", "__type", "__typename"}
+
 type DirectiveDrivenAuthenticator struct {
 	SkipAuthFor []string
 }
@@ -66,12 +70,11 @@ func (DirectiveDrivenAuthenticator) Validate(schema graphql.ExecutableSchema) er
 InterceptField 检查是否需要校验 Cookie 以及校验后的值设置
 */
 func (d DirectiveDrivenAuthenticator) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
-	rc := graphql.GetOperationContext(ctx)
 	fc := graphql.GetFieldContext(ctx)
 	// only apply auth checks to these
-	if rc.OperationName != "IntrospectionQuery" && tools.IsOneOf(fc.Object, "Query", "Mutation", "Subscription") {
-		// skip auth check
-		if tools.IsOneOf(fc.Field.Field.Name, d.SkipAuthFor...) {
+	if tools.IsOneOf(fc.Object, "Query", "Mutation", "Subscription") {
+		// skip auth check, 内省字段按字段名放行，operationName 由客户端指定不可信
+		if tools.IsOneOf(fc.Field.Field.Name, introspectionFields...) || tools.IsOneOf(fc.Field.Field.Name, d.SkipAuthFor...) {
 			return next(ctx)
 		}
 		// get cookie from context
@@ -84,15 +87,41 @@ func (d DirectiveDrivenAuthenticator) InterceptField(ctx context.Context, next g
 		if !ok {
 			return nil, errors.New("not valid cookie")
 		}
+		// WriterMiddleware 在没有 Cookie 时存入空字符串
+		if cookie == "" {
+			return nil, http.ErrNoCookie
+		}
 		// parse and validate JWT token using cookie
 		token, err := tools.ParseToken(cookie)
 		if err != nil {
 			return nil, err
 		}
 		// 保存当前用户 ID
-		ctx = context.WithValue(ctx, "UserID", token.UserID)
+		ctx = context.WithValue(ctx, tools.UserIDKey, token.UserID)
 		// 将 token 保存到上下文中，便于发送 GRPC 请求，由于 GRPC 请求 METADATA key 全小写，所以 Authorization 换成 token
 		ctx = context.WithValue(ctx, "token", cookie)
 	}
 	return next(ctx)
 }
+
+/*
+WebsocketInit 订阅建立 websocket 连接时校验 token，与 Cookie 的校验方式一致
+token 放在 connection_init 的 payload 中，key 与 Cookie 名相同，未携带时使用握手请求中的 Cookie
+*/
+func WebsocketInit(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
+	cookie := initPayload.GetString(tools.CookieName)
+	if cookie == "" {
+		cookie, _ = ctx.Value(tools.CookieName).(string)
+	}
+	if cookie == "" {
+		return nil, http.ErrNoCookie
+	}
+	token, err := tools.ParseToken(cookie)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, tools.CookieName, cookie)
+	ctx = context.WithValue(ctx, tools.UserIDKey, token.UserID)
+	ctx = context.WithValue(ctx, "token", cookie)
+	return ctx, nil
+}
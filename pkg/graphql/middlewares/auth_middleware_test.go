@@ -0,0 +1,47 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// intercept 模拟未登录请求的根字段经过中间件
+func intercept(operationName, object, field string) error {
+	ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{OperationName: operationName})
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Object: object, Field: graphql.CollectedField{Field: &ast.Field{Name: field}}})
+	_, err := NewAuthenticationMiddleware("login").InterceptField(ctx, func(ctx context.Context) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestInterceptField(t *testing.T) {
+	tests := []struct {
+		name          string
+		operationName string
+		object        string
+		field         string
+		want          error
+	}{
+		{name: "schema", object: "Query", field: "__schema"},
+		{name: "type", object: "Query", field: "__type"},
+		{name: "typename", object: "Mutation", field: "__typename"},
+		{name: "skipped", object: "Mutation", field: "login"},
+		{name: "nested", object: "User", field: "roles"},
+		{name: "protected", object: "Query", field: "users", want: http.ErrNoCookie},
+		// 操作名由客户端指定，不能用来跳过校验
+		{name: "named introspection", operationName: "IntrospectionQuery", object: "Query", field: "users", want: http.ErrNoCookie},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := intercept(tt.operationName, tt.object, tt.field); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -2,11 +2,50 @@
 
 package model
 
+import (
+	"time"
+
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/accesspolicy"
+)
+
+type DecideReq struct {
+	SubjectID          *string                `json:"subjectID"`
+	Action             string                 `json:"action"`
+	Resource           string                 `json:"resource"`
+	Subject            map[string]interface{} `json:"subject"`
+	ResourceAttributes map[string]interface{} `json:"resourceAttributes"`
+	Environment        map[string]interface{} `json:"environment"`
+}
+
+type GrantRoleReq struct {
+	UserID     string     `json:"userID"`
+	RoleID     string     `json:"roleID"`
+	ValidFrom  *time.Time `json:"validFrom"`
+	ValidUntil *time.Time `json:"validUntil"`
+}
+
+type PolicyDecision struct {
+	Allowed   bool                 `json:"allowed"`
+	Effect    *accesspolicy.Effect `json:"effect"`
+	PolicyIDs []string             `json:"policyIDs"`
+}
+
 type RegisterReq struct {
 	Phone string `json:"phone"`
 	Name  string `json:"name"`
 }
 
+type RoleChange struct {
+	Event *ent.AuditEvent `json:"event"`
+	Role  *ent.Role       `json:"role"`
+}
+
+type UserChange struct {
+	Event *ent.AuditEvent `json:"event"`
+	User  *ent.User       `json:"user"`
+}
+
 type LoginReq struct {
 	Phone string `json:"phone"`
 }
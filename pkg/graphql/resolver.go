@@ -1,20 +1,139 @@
 package graphql
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/pkg/abac"
 	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/schema"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/pkg/watch"
+	"github.com/stark-sim/cas/tools"
+	"strconv"
+	"strings"
 )
 
 // This file will not be regenerated automatically.
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
-type Resolver struct{ client *ent.Client }
+type Resolver struct {
+	client *ent.Client
+	abac   *abac.Engine
+	broker *watch.Broker
+}
 
-func NewSchema(client *ent.Client) graphql.ExecutableSchema {
+// NewSchema broker 需先通过 watch.Register 注册到 client 上，订阅才能及时收到变更
+func NewSchema(client *ent.Client, broker *watch.Broker) graphql.ExecutableSchema {
 	return NewExecutableSchema(Config{
-		Resolvers:  &Resolver{client: client},
+		Resolvers:  &Resolver{client: client, abac: abac.NewEngine(client), broker: broker},
 		Directives: DirectiveRoot{},
 		Complexity: ComplexityRoot{},
 	})
-}
\ No newline at end of file
+}
+
+var ErrForbidden = errors.New("forbidden")
+
+// db 变更使用 entgql.Transactioner 开启的事务，审计日志与 outbox 事件随变更一并提交
+func (r *Resolver) db(ctx context.Context) *ent.Client {
+	if client := ent.FromContext(ctx); client != nil {
+		return client
+	}
+	return r.client
+}
+
+// authorize 管理操作需要访问策略允许当前用户对 resource 执行 action
+func (r *Resolver) authorize(ctx context.Context, action string, resource string) error {
+	decision, err := r.abac.Decide(ctx, abac.Request{SubjectID: tools.GetUserID(ctx), Action: action, Resource: resource})
+	if err != nil {
+		return err
+	}
+	if !decision.Allowed {
+		return ErrForbidden
+	}
+	return nil
+}
+
+const (
+	// DefaultPageSize 未指定 first/last 时的分页大小，避免一次加载全部数据
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// limitPage 补全并限制分页大小
+func limitPage(first, last *int) (*int, *int) {
+	clamp := func(n *int) *int {
+		if n != nil && *n > MaxPageSize {
+			max := MaxPageSize
+			return &max
+		}
+		return n
+	}
+	if first == nil && last == nil {
+		size := DefaultPageSize
+		return &size, nil
+	}
+	return clamp(first), clamp(last)
+}
+
+/*
+subscribe 在后台按审计日志推送 types 的变更，直到订阅结束，结束后调用 done
+不检查权限，由调用方检查或过滤事件，resumeToken 无效时直接返回错误
+返回前已确定起点，之后提交的变更都会推送
+*/
+func (r *Resolver) subscribe(ctx context.Context, resumeToken *string, types []string, send func(*ent.AuditEvent) error, done func()) error {
+	token := ""
+	if resumeToken != nil {
+		token = *resumeToken
+	}
+	sub, err := watch.Subscribe(ctx, r.client, r.broker, watch.Options{Types: types, ResumeToken: token})
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer done()
+		err := sub.Run(ctx, send)
+		if err != nil && ctx.Err() == nil {
+			logrus.WithContext(ctx).Errorf("subscription of %v stopped, err: %v", types, err)
+		}
+	}()
+	return nil
+}
+
+/*
+concernsUser 角色或授权的变更是否涉及 userID 的授权，已撤销的授权同样算在内
+授权被物理删除后查不到，由调用方的定时检查兜底
+*/
+func (r *Resolver) concernsUser(ctx context.Context, event *ent.AuditEvent, userID int64) (bool, error) {
+	query := r.client.UserRole.Query().Where(userrole.UserID(userID))
+	switch event.EntityType {
+	case ent.TypeUserRole:
+		query.Where(userrole.ID(event.EntityID))
+	case ent.TypeRole:
+		query.Where(userrole.RoleID(event.EntityID))
+	default:
+		return false, nil
+	}
+	return query.Exist(schema.SkipSoftDelete(ctx))
+}
+
+// parseID 过滤条件中的 ID 以字符串传入，格式不正确时报错，不能当作 0 过滤
+func parseID(id string) (int64, error) {
+	tempID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %q", id)
+	}
+	return tempID, nil
+}
+
+// rolesKey 角色列表的指纹，用于判断是否变化
+func rolesKey(roles []*ent.Role) string {
+	var b strings.Builder
+	for _, v := range roles {
+		fmt.Fprintf(&b, "%d:%d;", v.ID, v.UpdatedAt.UnixNano())
+	}
+	return b.String()
+}
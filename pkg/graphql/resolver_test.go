@@ -0,0 +1,242 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stark-sim/cas/pkg/abac"
+	"github.com/stark-sim/cas/pkg/audit"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/enttest"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/pkg/graphql/model"
+	"github.com/stark-sim/cas/pkg/outbox"
+	"github.com/stark-sim/cas/pkg/rbac"
+	"github.com/stark-sim/cas/pkg/watch"
+	"github.com/stark-sim/cas/tools"
+)
+
+func newTestResolver(t *testing.T) *Resolver {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+	return &Resolver{client: client, abac: abac.NewEngine(client)}
+}
+
+// asUser 模拟登录中间件写入的当前用户
+func asUser(id int64) context.Context {
+	return context.WithValue(context.Background(), tools.UserIDKey, id)
+}
+
+// seedAdmin 创建 admin 角色与拥有该角色的用户，并允许 admin 授予、撤销角色
+func seedAdmin(t *testing.T, client *ent.Client) (admin *ent.User, adminRole *ent.Role) {
+	t.Helper()
+	ctx := context.Background()
+	for _, action := range []string{"grant", "revoke"} {
+		client.AccessPolicy.Create().SetAction(action).SetResource("role").SetCondition(`"admin" in subject.roles`).ExecX(ctx)
+	}
+	adminRole = client.Role.Create().SetName("admin").SaveX(ctx)
+	admin = client.User.Create().SetPhone("13800000000").SaveX(ctx)
+	if _, err := rbac.Grant(ctx, client, admin.ID, adminRole.ID, tools.ZeroTime, tools.ZeroTime); err != nil {
+		t.Fatal(err)
+	}
+	return admin, adminRole
+}
+
+func TestGrantRoleRequiresPermission(t *testing.T) {
+	r := newTestResolver(t)
+	admin, adminRole := seedAdmin(t, r.client)
+	u := r.client.User.Create().SetPhone("13800000001").SaveX(context.Background())
+	req := model.GrantRoleReq{UserID: strconv.FormatInt(u.ID, 10), RoleID: strconv.FormatInt(adminRole.ID, 10)}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{name: "anonymous", ctx: context.Background(), want: ErrForbidden},
+		{name: "without role", ctx: asUser(u.ID), want: ErrForbidden},
+		{name: "admin", ctx: asUser(admin.ID)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&mutationResolver{r}).GrantRole(tt.ctx, req)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+			granted := r.client.UserRole.Query().Where(userrole.UserID(u.ID), userrole.RoleID(adminRole.ID)).ExistX(context.Background())
+			if granted != (tt.want == nil) {
+				t.Errorf("granted: %v", granted)
+			}
+		})
+	}
+}
+
+func TestRevokeRoleRequiresPermission(t *testing.T) {
+	r := newTestResolver(t)
+	admin, adminRole := seedAdmin(t, r.client)
+	userID, roleID := strconv.FormatInt(admin.ID, 10), strconv.FormatInt(adminRole.ID, 10)
+	other := r.client.User.Create().SetPhone("13800000001").SaveX(context.Background())
+	if _, err := (&mutationResolver{r}).RevokeRole(asUser(other.ID), userID, roleID); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("got %v, want %v", err, ErrForbidden)
+	}
+	if roles, _ := rbac.UserRoles(context.Background(), r.client, admin.ID); len(roles) != 1 {
+		t.Fatalf("admin has %d roles after a rejected revoke", len(roles))
+	}
+	if _, err := (&mutationResolver{r}).RevokeRole(asUser(admin.ID), userID, roleID); err != nil {
+		t.Fatal(err)
+	}
+	if roles, _ := rbac.UserRoles(context.Background(), r.client, admin.ID); len(roles) != 0 {
+		t.Errorf("admin has %d roles after revoke", len(roles))
+	}
+}
+
+func TestMyRolesChanged(t *testing.T) {
+	r := newTestResolver(t)
+	audit.Register(r.client)
+	r.broker = watch.NewBroker(time.Second, 50*time.Millisecond)
+	t.Cleanup(r.broker.Close)
+	watch.Register(r.client, r.broker)
+	bg := context.Background()
+	u := r.client.User.Create().SetPhone("13800000000").SaveX(bg)
+	other := r.client.User.Create().SetPhone("13800000001").SaveX(bg)
+	ctx, cancel := context.WithCancel(asUser(u.ID))
+	defer cancel()
+	// 普通用户不能订阅全部变更，但可以订阅自己的角色
+	if _, err := (&subscriptionResolver{r}).RoleChanged(ctx, nil); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("RoleChanged: got %v, want %v", err, ErrForbidden)
+	}
+	ch, err := (&subscriptionResolver{r}).MyRolesChanged(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive := func() []*ent.Role {
+		t.Helper()
+		select {
+		case roles := <-ch:
+			return roles
+		case <-time.After(5 * time.Second):
+			t.Fatal("no roles pushed")
+		}
+		return nil
+	}
+	if roles := receive(); len(roles) != 0 {
+		t.Fatalf("initial roles %v", roles)
+	}
+	_role := r.client.Role.Create().SetName("editor").SaveX(bg)
+	if _, err = rbac.Grant(bg, r.client, other.ID, _role.ID, tools.ZeroTime, tools.ZeroTime); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rbac.Grant(bg, r.client, u.ID, _role.ID, tools.ZeroTime, tools.ZeroTime); err != nil {
+		t.Fatal(err)
+	}
+	if roles := receive(); len(roles) != 1 || roles[0].ID != _role.ID {
+		t.Fatalf("roles after grant %v", roles)
+	}
+	r.client.Role.UpdateOne(_role).SetName("writer").ExecX(bg)
+	if roles := receive(); len(roles) != 1 || roles[0].Name != "writer" {
+		t.Fatalf("roles after rename %v", roles)
+	}
+}
+
+func TestConcernsUser(t *testing.T) {
+	r := newTestResolver(t)
+	ctx := context.Background()
+	u := r.client.User.Create().SetPhone("13800000000").SaveX(ctx)
+	other := r.client.User.Create().SetPhone("13800000001").SaveX(ctx)
+	mine := r.client.Role.Create().SetName("mine").SaveX(ctx)
+	theirs := r.client.Role.Create().SetName("theirs").SaveX(ctx)
+	own, err := rbac.Grant(ctx, r.client, u.ID, mine.ID, tools.ZeroTime, tools.ZeroTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign, err := rbac.Grant(ctx, r.client, other.ID, theirs.ID, tools.ZeroTime, tools.ZeroTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 撤销后仍然涉及
+	if err = rbac.Revoke(ctx, r.client, u.ID, mine.ID); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		event *ent.AuditEvent
+		want  bool
+	}{
+		{name: "own grant", event: &ent.AuditEvent{EntityType: ent.TypeUserRole, EntityID: own.ID}, want: true},
+		{name: "foreign grant", event: &ent.AuditEvent{EntityType: ent.TypeUserRole, EntityID: foreign.ID}},
+		{name: "own role", event: &ent.AuditEvent{EntityType: ent.TypeRole, EntityID: mine.ID}, want: true},
+		{name: "foreign role", event: &ent.AuditEvent{EntityType: ent.TypeRole, EntityID: theirs.ID}},
+		{name: "user", event: &ent.AuditEvent{EntityType: ent.TypeUser, EntityID: u.ID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.concernsUser(ctx, tt.event, u.ID)
+			if err != nil || got != tt.want {
+				t.Errorf("got %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeAccessPolicyRequiresPermission(t *testing.T) {
+	r := newTestResolver(t)
+	ctx := context.Background()
+	r.client.AccessPolicy.Create().SetAction("manage").SetResource("policy").SetCondition(`"admin" in subject.roles`).ExecX(ctx)
+	admin, _ := seedAdmin(t, r.client)
+	u := r.client.User.Create().SetPhone("13800000001").SaveX(ctx)
+	policy := r.client.AccessPolicy.Query().FirstX(ctx)
+	id := strconv.FormatInt(policy.ID, 10)
+	if _, err := (&queryResolver{r}).Node(asUser(u.ID), id); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Node: got %v, want %v", err, ErrForbidden)
+	}
+	if _, err := (&queryResolver{r}).Nodes(asUser(u.ID), []string{strconv.FormatInt(u.ID, 10), id}); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Nodes: got %v, want %v", err, ErrForbidden)
+	}
+	// 其他类型的节点不受影响
+	if _, err := (&queryResolver{r}).Nodes(asUser(u.ID), []string{strconv.FormatInt(u.ID, 10)}); err != nil {
+		t.Errorf("Nodes without policies: %v", err)
+	}
+	if node, err := (&queryResolver{r}).Node(asUser(admin.ID), id); err != nil || node.(*ent.AccessPolicy).ID != policy.ID {
+		t.Errorf("admin Node: got %v, %v", node, err)
+	}
+	nodes, err := (&queryResolver{r}).Nodes(asUser(admin.ID), []string{id})
+	if err != nil || len(nodes) != 1 {
+		t.Errorf("admin Nodes: got %v, %v", nodes, err)
+	}
+}
+
+func TestReplayOutboxEventRequiresPermission(t *testing.T) {
+	r := newTestResolver(t)
+	ctx := context.Background()
+	r.client.AccessPolicy.Create().SetAction("manage").SetResource("outbox").SetCondition(`"admin" in subject.roles`).ExecX(ctx)
+	admin, _ := seedAdmin(t, r.client)
+	u := r.client.User.Create().SetPhone("13800000001").SaveX(ctx)
+	event := r.client.OutboxEvent.Create().
+		SetEventType(outbox.UserUpdated).
+		SetAggregateType(ent.TypeUser).
+		SetAggregateID(u.ID).
+		SetPayload(json.RawMessage(`{}`)).
+		SetAttempts(8).
+		SetLastError("bus unavailable").
+		SetDeadAt(time.Now()).
+		SaveX(ctx)
+	id := strconv.FormatInt(event.ID, 10)
+	if _, err := (&mutationResolver{r}).ReplayOutboxEvent(asUser(u.ID), id); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("got %v, want %v", err, ErrForbidden)
+	}
+	if dead := r.client.OutboxEvent.GetX(ctx, event.ID); dead.DeadAt.Equal(tools.ZeroTime) {
+		t.Fatal("replayed by a user without permission")
+	}
+	replayed, err := (&mutationResolver{r}).ReplayOutboxEvent(asUser(admin.ID), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !replayed.DeadAt.Equal(tools.ZeroTime) || replayed.Attempts != 0 || replayed.LastError != "" {
+		t.Errorf("replayed: dead_at %v, attempts %d, last_error %q", replayed.DeadAt, replayed.Attempts, replayed.LastError)
+	}
+}
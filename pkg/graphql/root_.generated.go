@@ -33,12 +33,20 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	AccessPolicy() AccessPolicyResolver
+	AuditEvent() AuditEventResolver
 	Entity() EntityResolver
 	Mutation() MutationResolver
+	OutboxEvent() OutboxEventResolver
 	Query() QueryResolver
 	Role() RoleResolver
+	Subscription() SubscriptionResolver
 	User() UserResolver
 	UserRole() UserRoleResolver
+	WebhookDelivery() WebhookDeliveryResolver
+	WebhookSubscription() WebhookSubscriptionResolver
+	AccessPolicyWhereInput() AccessPolicyWhereInputResolver
+	AuditEventWhereInput() AuditEventWhereInputResolver
 	CreateRoleInput() CreateRoleInputResolver
 	CreateUserInput() CreateUserInputResolver
 	RoleWhereInput() RoleWhereInputResolver
@@ -46,24 +54,89 @@ type ResolverRoot interface {
 	UpdateUserInput() UpdateUserInputResolver
 	UserRoleWhereInput() UserRoleWhereInputResolver
 	UserWhereInput() UserWhereInputResolver
+	WebhookDeliveryWhereInput() WebhookDeliveryWhereInputResolver
+	WebhookSubscriptionWhereInput() WebhookSubscriptionWhereInputResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AccessPolicy struct {
+		Action      func(childComplexity int) int
+		Condition   func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		CreatedBy   func(childComplexity int) int
+		DeletedAt   func(childComplexity int) int
+		Description func(childComplexity int) int
+		Effect      func(childComplexity int) int
+		Enabled     func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Resource    func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+		UpdatedBy   func(childComplexity int) int
+	}
+
+	AuditEvent struct {
+		ActorID    func(childComplexity int) int
+		Changes    func(childComplexity int) int
+		ClientIP   func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		EntityID   func(childComplexity int) int
+		EntityType func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Operation  func(childComplexity int) int
+		RequestID  func(childComplexity int) int
+	}
+
+	AuditEventConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	AuditEventEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Entity struct {
 		FindUserByID func(childComplexity int, id string) int
 	}
 
 	Mutation struct {
-		CreateRole func(childComplexity int, input ent.CreateRoleInput) int
-		CreateUser func(childComplexity int, input ent.CreateUserInput) int
-		DeleteRole func(childComplexity int, id string) int
-		DeleteUser func(childComplexity int, id string) int
-		Register   func(childComplexity int, req model.RegisterReq) int
-		UpdateRole func(childComplexity int, id string, input ent.UpdateRoleInput) int
-		UpdateUser func(childComplexity int, id string, input ent.UpdateUserInput) int
+		CreateAccessPolicy        func(childComplexity int, input ent.CreateAccessPolicyInput) int
+		CreateRole                func(childComplexity int, input ent.CreateRoleInput) int
+		CreateUser                func(childComplexity int, input ent.CreateUserInput) int
+		CreateWebhookSubscription func(childComplexity int, input ent.CreateWebhookSubscriptionInput) int
+		DeleteAccessPolicy        func(childComplexity int, id string) int
+		DeleteRole                func(childComplexity int, id string) int
+		DeleteUser                func(childComplexity int, id string) int
+		DeleteWebhookSubscription func(childComplexity int, id string) int
+		GrantRole                 func(childComplexity int, req model.GrantRoleReq) int
+		PurgeRole                 func(childComplexity int, id string) int
+		PurgeUser                 func(childComplexity int, id string) int
+		Register                  func(childComplexity int, req model.RegisterReq) int
+		ReplayOutboxEvent         func(childComplexity int, id string) int
+		ReplayWebhookDelivery     func(childComplexity int, id string) int
+		RestoreRole               func(childComplexity int, id string, withAssignments *bool) int
+		RestoreUser               func(childComplexity int, id string, withAssignments *bool) int
+		RevokeRole                func(childComplexity int, userID string, roleID string) int
+		UpdateAccessPolicy        func(childComplexity int, id string, input ent.UpdateAccessPolicyInput) int
+		UpdateRole                func(childComplexity int, id string, input ent.UpdateRoleInput) int
+		UpdateUser                func(childComplexity int, id string, input ent.UpdateUserInput) int
+		UpdateWebhookSubscription func(childComplexity int, id string, input ent.UpdateWebhookSubscriptionInput) int
+	}
+
+	OutboxEvent struct {
+		AggregateID   func(childComplexity int) int
+		AggregateType func(childComplexity int) int
+		Attempts      func(childComplexity int) int
+		DeadAt        func(childComplexity int) int
+		EventType     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		LastError     func(childComplexity int) int
 	}
 
 	PageInfo struct {
@@ -73,14 +146,27 @@ type ComplexityRoot struct {
 		StartCursor     func(childComplexity int) int
 	}
 
+	PolicyDecision struct {
+		Allowed   func(childComplexity int) int
+		Effect    func(childComplexity int) int
+		PolicyIDs func(childComplexity int) int
+	}
+
 	Query struct {
-		Login              func(childComplexity int, req model.LoginReq) int
-		Node               func(childComplexity int, id string) int
-		Nodes              func(childComplexity int, ids []string) int
-		Roles              func(childComplexity int) int
-		Users              func(childComplexity int) int
-		__resolve__service func(childComplexity int) int
-		__resolve_entities func(childComplexity int, representations []map[string]interface{}) int
+		AccessPolicies       func(childComplexity int) int
+		AuditEvents          func(childComplexity int, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.AuditEventOrder, where *ent.AuditEventWhereInput) int
+		Decide               func(childComplexity int, req model.DecideReq) int
+		DeletedRoles         func(childComplexity int) int
+		DeletedUsers         func(childComplexity int) int
+		Login                func(childComplexity int, req model.LoginReq) int
+		Node                 func(childComplexity int, id string) int
+		Nodes                func(childComplexity int, ids []string) int
+		Roles                func(childComplexity int, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.RoleOrder, where *ent.RoleWhereInput) int
+		Users                func(childComplexity int, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.UserOrder, where *ent.UserWhereInput) int
+		WebhookDeliveries    func(childComplexity int, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.WebhookDeliveryOrder, where *ent.WebhookDeliveryWhereInput) int
+		WebhookSubscriptions func(childComplexity int) int
+		__resolve__service   func(childComplexity int) int
+		__resolve_entities   func(childComplexity int, representations []map[string]interface{}) int
 	}
 
 	Role struct {
@@ -92,7 +178,29 @@ type ComplexityRoot struct {
 		UpdatedAt func(childComplexity int) int
 		UpdatedBy func(childComplexity int) int
 		UserRoles func(childComplexity int) int
-		Users     func(childComplexity int) int
+		Users     func(childComplexity int, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.UserOrder, where *ent.UserWhereInput) int
+	}
+
+	RoleChange struct {
+		Event func(childComplexity int) int
+		Role  func(childComplexity int) int
+	}
+
+	RoleConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	RoleEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	Subscription struct {
+		MyRolesChanged func(childComplexity int) int
+		RoleChanged    func(childComplexity int, resumeToken *string) int
+		UserChanged    func(childComplexity int, resumeToken *string) int
 	}
 
 	User struct {
@@ -102,23 +210,82 @@ type ComplexityRoot struct {
 		ID        func(childComplexity int) int
 		Name      func(childComplexity int) int
 		Phone     func(childComplexity int) int
-		Roles     func(childComplexity int) int
+		Roles     func(childComplexity int, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.RoleOrder, where *ent.RoleWhereInput) int
 		UpdatedAt func(childComplexity int) int
 		UpdatedBy func(childComplexity int) int
 		UserRoles func(childComplexity int) int
 	}
 
+	UserChange struct {
+		Event func(childComplexity int) int
+		User  func(childComplexity int) int
+	}
+
+	UserConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	UserEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	UserRole struct {
-		CreatedAt func(childComplexity int) int
-		CreatedBy func(childComplexity int) int
-		DeletedAt func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Role      func(childComplexity int) int
-		RoleID    func(childComplexity int) int
-		UpdatedAt func(childComplexity int) int
-		UpdatedBy func(childComplexity int) int
-		User      func(childComplexity int) int
-		UserID    func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		CreatedBy  func(childComplexity int) int
+		DeletedAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Role       func(childComplexity int) int
+		RoleID     func(childComplexity int) int
+		UpdatedAt  func(childComplexity int) int
+		UpdatedBy  func(childComplexity int) int
+		User       func(childComplexity int) int
+		UserID     func(childComplexity int) int
+		ValidFrom  func(childComplexity int) int
+		ValidUntil func(childComplexity int) int
+	}
+
+	WebhookDelivery struct {
+		Attempts       func(childComplexity int) int
+		Body           func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		DeliveredAt    func(childComplexity int) int
+		EventID        func(childComplexity int) int
+		EventType      func(childComplexity int) int
+		ID             func(childComplexity int) int
+		LastError      func(childComplexity int) int
+		NextAttemptAt  func(childComplexity int) int
+		ResponseStatus func(childComplexity int) int
+		Status         func(childComplexity int) int
+		Subscription   func(childComplexity int) int
+		SubscriptionID func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
+	WebhookDeliveryConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	WebhookDeliveryEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	WebhookSubscription struct {
+		CreatedAt  func(childComplexity int) int
+		CreatedBy  func(childComplexity int) int
+		DeletedAt  func(childComplexity int) int
+		Enabled    func(childComplexity int) int
+		EventTypes func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Name       func(childComplexity int) int
+		URL        func(childComplexity int) int
+		UpdatedAt  func(childComplexity int) int
+		UpdatedBy  func(childComplexity int) int
 	}
 
 	_Service struct {
@@ -141,6 +308,195 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AccessPolicy.action":
+		if e.complexity.AccessPolicy.Action == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.Action(childComplexity), true
+
+	case "AccessPolicy.condition":
+		if e.complexity.AccessPolicy.Condition == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.Condition(childComplexity), true
+
+	case "AccessPolicy.createdAt":
+		if e.complexity.AccessPolicy.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.CreatedAt(childComplexity), true
+
+	case "AccessPolicy.createdBy":
+		if e.complexity.AccessPolicy.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.CreatedBy(childComplexity), true
+
+	case "AccessPolicy.deletedAt":
+		if e.complexity.AccessPolicy.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.DeletedAt(childComplexity), true
+
+	case "AccessPolicy.description":
+		if e.complexity.AccessPolicy.Description == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.Description(childComplexity), true
+
+	case "AccessPolicy.effect":
+		if e.complexity.AccessPolicy.Effect == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.Effect(childComplexity), true
+
+	case "AccessPolicy.enabled":
+		if e.complexity.AccessPolicy.Enabled == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.Enabled(childComplexity), true
+
+	case "AccessPolicy.id":
+		if e.complexity.AccessPolicy.ID == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.ID(childComplexity), true
+
+	case "AccessPolicy.name":
+		if e.complexity.AccessPolicy.Name == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.Name(childComplexity), true
+
+	case "AccessPolicy.resource":
+		if e.complexity.AccessPolicy.Resource == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.Resource(childComplexity), true
+
+	case "AccessPolicy.updatedAt":
+		if e.complexity.AccessPolicy.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.UpdatedAt(childComplexity), true
+
+	case "AccessPolicy.updatedBy":
+		if e.complexity.AccessPolicy.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.AccessPolicy.UpdatedBy(childComplexity), true
+
+	case "AuditEvent.actorID":
+		if e.complexity.AuditEvent.ActorID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.ActorID(childComplexity), true
+
+	case "AuditEvent.changes":
+		if e.complexity.AuditEvent.Changes == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Changes(childComplexity), true
+
+	case "AuditEvent.clientIP":
+		if e.complexity.AuditEvent.ClientIP == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.ClientIP(childComplexity), true
+
+	case "AuditEvent.createdAt":
+		if e.complexity.AuditEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.CreatedAt(childComplexity), true
+
+	case "AuditEvent.entityID":
+		if e.complexity.AuditEvent.EntityID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.EntityID(childComplexity), true
+
+	case "AuditEvent.entityType":
+		if e.complexity.AuditEvent.EntityType == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.EntityType(childComplexity), true
+
+	case "AuditEvent.id":
+		if e.complexity.AuditEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.ID(childComplexity), true
+
+	case "AuditEvent.operation":
+		if e.complexity.AuditEvent.Operation == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.Operation(childComplexity), true
+
+	case "AuditEvent.requestID":
+		if e.complexity.AuditEvent.RequestID == nil {
+			break
+		}
+
+		return e.complexity.AuditEvent.RequestID(childComplexity), true
+
+	case "AuditEventConnection.edges":
+		if e.complexity.AuditEventConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.AuditEventConnection.Edges(childComplexity), true
+
+	case "AuditEventConnection.pageInfo":
+		if e.complexity.AuditEventConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.AuditEventConnection.PageInfo(childComplexity), true
+
+	case "AuditEventConnection.totalCount":
+		if e.complexity.AuditEventConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.AuditEventConnection.TotalCount(childComplexity), true
+
+	case "AuditEventEdge.cursor":
+		if e.complexity.AuditEventEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.AuditEventEdge.Cursor(childComplexity), true
+
+	case "AuditEventEdge.node":
+		if e.complexity.AuditEventEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.AuditEventEdge.Node(childComplexity), true
+
 	case "Entity.findUserByID":
 		if e.complexity.Entity.FindUserByID == nil {
 			break
@@ -153,6 +509,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Entity.FindUserByID(childComplexity, args["id"].(string)), true
 
+	case "Mutation.createAccessPolicy":
+		if e.complexity.Mutation.CreateAccessPolicy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAccessPolicy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAccessPolicy(childComplexity, args["input"].(ent.CreateAccessPolicyInput)), true
+
 	case "Mutation.createRole":
 		if e.complexity.Mutation.CreateRole == nil {
 			break
@@ -177,6 +545,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.CreateUser(childComplexity, args["input"].(ent.CreateUserInput)), true
 
+	case "Mutation.createWebhookSubscription":
+		if e.complexity.Mutation.CreateWebhookSubscription == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createWebhookSubscription_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateWebhookSubscription(childComplexity, args["input"].(ent.CreateWebhookSubscriptionInput)), true
+
+	case "Mutation.deleteAccessPolicy":
+		if e.complexity.Mutation.DeleteAccessPolicy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteAccessPolicy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteAccessPolicy(childComplexity, args["id"].(string)), true
+
 	case "Mutation.deleteRole":
 		if e.complexity.Mutation.DeleteRole == nil {
 			break
@@ -201,6 +593,54 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteUser(childComplexity, args["id"].(string)), true
 
+	case "Mutation.deleteWebhookSubscription":
+		if e.complexity.Mutation.DeleteWebhookSubscription == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteWebhookSubscription_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteWebhookSubscription(childComplexity, args["id"].(string)), true
+
+	case "Mutation.grantRole":
+		if e.complexity.Mutation.GrantRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_grantRole_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.GrantRole(childComplexity, args["req"].(model.GrantRoleReq)), true
+
+	case "Mutation.purgeRole":
+		if e.complexity.Mutation.PurgeRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_purgeRole_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PurgeRole(childComplexity, args["id"].(string)), true
+
+	case "Mutation.purgeUser":
+		if e.complexity.Mutation.PurgeUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_purgeUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PurgeUser(childComplexity, args["id"].(string)), true
+
 	case "Mutation.register":
 		if e.complexity.Mutation.Register == nil {
 			break
@@ -213,6 +653,78 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.Register(childComplexity, args["req"].(model.RegisterReq)), true
 
+	case "Mutation.replayOutboxEvent":
+		if e.complexity.Mutation.ReplayOutboxEvent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_replayOutboxEvent_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReplayOutboxEvent(childComplexity, args["id"].(string)), true
+
+	case "Mutation.replayWebhookDelivery":
+		if e.complexity.Mutation.ReplayWebhookDelivery == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_replayWebhookDelivery_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReplayWebhookDelivery(childComplexity, args["id"].(string)), true
+
+	case "Mutation.restoreRole":
+		if e.complexity.Mutation.RestoreRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreRole_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreRole(childComplexity, args["id"].(string), args["withAssignments"].(*bool)), true
+
+	case "Mutation.restoreUser":
+		if e.complexity.Mutation.RestoreUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreUser(childComplexity, args["id"].(string), args["withAssignments"].(*bool)), true
+
+	case "Mutation.revokeRole":
+		if e.complexity.Mutation.RevokeRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeRole_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeRole(childComplexity, args["userID"].(string), args["roleID"].(string)), true
+
+	case "Mutation.updateAccessPolicy":
+		if e.complexity.Mutation.UpdateAccessPolicy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateAccessPolicy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateAccessPolicy(childComplexity, args["id"].(string), args["input"].(ent.UpdateAccessPolicyInput)), true
+
 	case "Mutation.updateRole":
 		if e.complexity.Mutation.UpdateRole == nil {
 			break
@@ -235,35 +747,162 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateUser(childComplexity, args["id"].(string), args["input"].(ent.UpdateUserInput)), true
+		return e.complexity.Mutation.UpdateUser(childComplexity, args["id"].(string), args["input"].(ent.UpdateUserInput)), true
+
+	case "Mutation.updateWebhookSubscription":
+		if e.complexity.Mutation.UpdateWebhookSubscription == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateWebhookSubscription_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateWebhookSubscription(childComplexity, args["id"].(string), args["input"].(ent.UpdateWebhookSubscriptionInput)), true
+
+	case "OutboxEvent.aggregateID":
+		if e.complexity.OutboxEvent.AggregateID == nil {
+			break
+		}
+
+		return e.complexity.OutboxEvent.AggregateID(childComplexity), true
+
+	case "OutboxEvent.aggregateType":
+		if e.complexity.OutboxEvent.AggregateType == nil {
+			break
+		}
+
+		return e.complexity.OutboxEvent.AggregateType(childComplexity), true
+
+	case "OutboxEvent.attempts":
+		if e.complexity.OutboxEvent.Attempts == nil {
+			break
+		}
+
+		return e.complexity.OutboxEvent.Attempts(childComplexity), true
+
+	case "OutboxEvent.deadAt":
+		if e.complexity.OutboxEvent.DeadAt == nil {
+			break
+		}
+
+		return e.complexity.OutboxEvent.DeadAt(childComplexity), true
+
+	case "OutboxEvent.eventType":
+		if e.complexity.OutboxEvent.EventType == nil {
+			break
+		}
+
+		return e.complexity.OutboxEvent.EventType(childComplexity), true
+
+	case "OutboxEvent.id":
+		if e.complexity.OutboxEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.OutboxEvent.ID(childComplexity), true
+
+	case "OutboxEvent.lastError":
+		if e.complexity.OutboxEvent.LastError == nil {
+			break
+		}
+
+		return e.complexity.OutboxEvent.LastError(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "PolicyDecision.allowed":
+		if e.complexity.PolicyDecision.Allowed == nil {
+			break
+		}
+
+		return e.complexity.PolicyDecision.Allowed(childComplexity), true
+
+	case "PolicyDecision.effect":
+		if e.complexity.PolicyDecision.Effect == nil {
+			break
+		}
+
+		return e.complexity.PolicyDecision.Effect(childComplexity), true
+
+	case "PolicyDecision.policyIDs":
+		if e.complexity.PolicyDecision.PolicyIDs == nil {
+			break
+		}
+
+		return e.complexity.PolicyDecision.PolicyIDs(childComplexity), true
+
+	case "Query.accessPolicies":
+		if e.complexity.Query.AccessPolicies == nil {
+			break
+		}
+
+		return e.complexity.Query.AccessPolicies(childComplexity), true
+
+	case "Query.auditEvents":
+		if e.complexity.Query.AuditEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Query_auditEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AuditEvents(childComplexity, args["after"].(*ent.Cursor), args["first"].(*int), args["before"].(*ent.Cursor), args["last"].(*int), args["orderBy"].(*ent.AuditEventOrder), args["where"].(*ent.AuditEventWhereInput)), true
 
-	case "PageInfo.endCursor":
-		if e.complexity.PageInfo.EndCursor == nil {
+	case "Query.decide":
+		if e.complexity.Query.Decide == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.EndCursor(childComplexity), true
-
-	case "PageInfo.hasNextPage":
-		if e.complexity.PageInfo.HasNextPage == nil {
-			break
+		args, err := ec.field_Query_decide_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+		return e.complexity.Query.Decide(childComplexity, args["req"].(model.DecideReq)), true
 
-	case "PageInfo.hasPreviousPage":
-		if e.complexity.PageInfo.HasPreviousPage == nil {
+	case "Query.deletedRoles":
+		if e.complexity.Query.DeletedRoles == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+		return e.complexity.Query.DeletedRoles(childComplexity), true
 
-	case "PageInfo.startCursor":
-		if e.complexity.PageInfo.StartCursor == nil {
+	case "Query.deletedUsers":
+		if e.complexity.Query.DeletedUsers == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.StartCursor(childComplexity), true
+		return e.complexity.Query.DeletedUsers(childComplexity), true
 
 	case "Query.login":
 		if e.complexity.Query.Login == nil {
@@ -306,14 +945,43 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			break
 		}
 
-		return e.complexity.Query.Roles(childComplexity), true
+		args, err := ec.field_Query_roles_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Roles(childComplexity, args["after"].(*ent.Cursor), args["first"].(*int), args["before"].(*ent.Cursor), args["last"].(*int), args["orderBy"].(*ent.RoleOrder), args["where"].(*ent.RoleWhereInput)), true
 
 	case "Query.users":
 		if e.complexity.Query.Users == nil {
 			break
 		}
 
-		return e.complexity.Query.Users(childComplexity), true
+		args, err := ec.field_Query_users_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Users(childComplexity, args["after"].(*ent.Cursor), args["first"].(*int), args["before"].(*ent.Cursor), args["last"].(*int), args["orderBy"].(*ent.UserOrder), args["where"].(*ent.UserWhereInput)), true
+
+	case "Query.webhookDeliveries":
+		if e.complexity.Query.WebhookDeliveries == nil {
+			break
+		}
+
+		args, err := ec.field_Query_webhookDeliveries_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.WebhookDeliveries(childComplexity, args["after"].(*ent.Cursor), args["first"].(*int), args["before"].(*ent.Cursor), args["last"].(*int), args["orderBy"].(*ent.WebhookDeliveryOrder), args["where"].(*ent.WebhookDeliveryWhereInput)), true
+
+	case "Query.webhookSubscriptions":
+		if e.complexity.Query.WebhookSubscriptions == nil {
+			break
+		}
+
+		return e.complexity.Query.WebhookSubscriptions(childComplexity), true
 
 	case "Query._service":
 		if e.complexity.Query.__resolve__service == nil {
@@ -395,7 +1063,92 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			break
 		}
 
-		return e.complexity.Role.Users(childComplexity), true
+		args, err := ec.field_Role_users_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Role.Users(childComplexity, args["after"].(*ent.Cursor), args["first"].(*int), args["before"].(*ent.Cursor), args["last"].(*int), args["orderBy"].(*ent.UserOrder), args["where"].(*ent.UserWhereInput)), true
+
+	case "RoleChange.event":
+		if e.complexity.RoleChange.Event == nil {
+			break
+		}
+
+		return e.complexity.RoleChange.Event(childComplexity), true
+
+	case "RoleChange.role":
+		if e.complexity.RoleChange.Role == nil {
+			break
+		}
+
+		return e.complexity.RoleChange.Role(childComplexity), true
+
+	case "RoleConnection.edges":
+		if e.complexity.RoleConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.RoleConnection.Edges(childComplexity), true
+
+	case "RoleConnection.pageInfo":
+		if e.complexity.RoleConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.RoleConnection.PageInfo(childComplexity), true
+
+	case "RoleConnection.totalCount":
+		if e.complexity.RoleConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.RoleConnection.TotalCount(childComplexity), true
+
+	case "RoleEdge.cursor":
+		if e.complexity.RoleEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.RoleEdge.Cursor(childComplexity), true
+
+	case "RoleEdge.node":
+		if e.complexity.RoleEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.RoleEdge.Node(childComplexity), true
+
+	case "Subscription.myRolesChanged":
+		if e.complexity.Subscription.MyRolesChanged == nil {
+			break
+		}
+
+		return e.complexity.Subscription.MyRolesChanged(childComplexity), true
+
+	case "Subscription.roleChanged":
+		if e.complexity.Subscription.RoleChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_roleChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.RoleChanged(childComplexity, args["resumeToken"].(*string)), true
+
+	case "Subscription.userChanged":
+		if e.complexity.Subscription.UserChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_userChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.UserChanged(childComplexity, args["resumeToken"].(*string)), true
 
 	case "User.createdAt":
 		if e.complexity.User.CreatedAt == nil {
@@ -444,7 +1197,12 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			break
 		}
 
-		return e.complexity.User.Roles(childComplexity), true
+		args, err := ec.field_User_roles_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Roles(childComplexity, args["after"].(*ent.Cursor), args["first"].(*int), args["before"].(*ent.Cursor), args["last"].(*int), args["orderBy"].(*ent.RoleOrder), args["where"].(*ent.RoleWhereInput)), true
 
 	case "User.updatedAt":
 		if e.complexity.User.UpdatedAt == nil {
@@ -467,6 +1225,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.User.UserRoles(childComplexity), true
 
+	case "UserChange.event":
+		if e.complexity.UserChange.Event == nil {
+			break
+		}
+
+		return e.complexity.UserChange.Event(childComplexity), true
+
+	case "UserChange.user":
+		if e.complexity.UserChange.User == nil {
+			break
+		}
+
+		return e.complexity.UserChange.User(childComplexity), true
+
+	case "UserConnection.edges":
+		if e.complexity.UserConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.Edges(childComplexity), true
+
+	case "UserConnection.pageInfo":
+		if e.complexity.UserConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.PageInfo(childComplexity), true
+
+	case "UserConnection.totalCount":
+		if e.complexity.UserConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.TotalCount(childComplexity), true
+
+	case "UserEdge.cursor":
+		if e.complexity.UserEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.UserEdge.Cursor(childComplexity), true
+
+	case "UserEdge.node":
+		if e.complexity.UserEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.UserEdge.Node(childComplexity), true
+
 	case "UserRole.createdAt":
 		if e.complexity.UserRole.CreatedAt == nil {
 			break
@@ -537,6 +1344,223 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UserRole.UserID(childComplexity), true
 
+	case "UserRole.validFrom":
+		if e.complexity.UserRole.ValidFrom == nil {
+			break
+		}
+
+		return e.complexity.UserRole.ValidFrom(childComplexity), true
+
+	case "UserRole.validUntil":
+		if e.complexity.UserRole.ValidUntil == nil {
+			break
+		}
+
+		return e.complexity.UserRole.ValidUntil(childComplexity), true
+
+	case "WebhookDelivery.attempts":
+		if e.complexity.WebhookDelivery.Attempts == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Attempts(childComplexity), true
+
+	case "WebhookDelivery.body":
+		if e.complexity.WebhookDelivery.Body == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Body(childComplexity), true
+
+	case "WebhookDelivery.createdAt":
+		if e.complexity.WebhookDelivery.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.CreatedAt(childComplexity), true
+
+	case "WebhookDelivery.deliveredAt":
+		if e.complexity.WebhookDelivery.DeliveredAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.DeliveredAt(childComplexity), true
+
+	case "WebhookDelivery.eventID":
+		if e.complexity.WebhookDelivery.EventID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.EventID(childComplexity), true
+
+	case "WebhookDelivery.eventType":
+		if e.complexity.WebhookDelivery.EventType == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.EventType(childComplexity), true
+
+	case "WebhookDelivery.id":
+		if e.complexity.WebhookDelivery.ID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.ID(childComplexity), true
+
+	case "WebhookDelivery.lastError":
+		if e.complexity.WebhookDelivery.LastError == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.LastError(childComplexity), true
+
+	case "WebhookDelivery.nextAttemptAt":
+		if e.complexity.WebhookDelivery.NextAttemptAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.NextAttemptAt(childComplexity), true
+
+	case "WebhookDelivery.responseStatus":
+		if e.complexity.WebhookDelivery.ResponseStatus == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.ResponseStatus(childComplexity), true
+
+	case "WebhookDelivery.status":
+		if e.complexity.WebhookDelivery.Status == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Status(childComplexity), true
+
+	case "WebhookDelivery.subscription":
+		if e.complexity.WebhookDelivery.Subscription == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Subscription(childComplexity), true
+
+	case "WebhookDelivery.subscriptionID":
+		if e.complexity.WebhookDelivery.SubscriptionID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.SubscriptionID(childComplexity), true
+
+	case "WebhookDelivery.updatedAt":
+		if e.complexity.WebhookDelivery.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.UpdatedAt(childComplexity), true
+
+	case "WebhookDeliveryConnection.edges":
+		if e.complexity.WebhookDeliveryConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryConnection.Edges(childComplexity), true
+
+	case "WebhookDeliveryConnection.pageInfo":
+		if e.complexity.WebhookDeliveryConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryConnection.PageInfo(childComplexity), true
+
+	case "WebhookDeliveryConnection.totalCount":
+		if e.complexity.WebhookDeliveryConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryConnection.TotalCount(childComplexity), true
+
+	case "WebhookDeliveryEdge.cursor":
+		if e.complexity.WebhookDeliveryEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryEdge.Cursor(childComplexity), true
+
+	case "WebhookDeliveryEdge.node":
+		if e.complexity.WebhookDeliveryEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryEdge.Node(childComplexity), true
+
+	case "WebhookSubscription.createdAt":
+		if e.complexity.WebhookSubscription.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.CreatedAt(childComplexity), true
+
+	case "WebhookSubscription.createdBy":
+		if e.complexity.WebhookSubscription.CreatedBy == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.CreatedBy(childComplexity), true
+
+	case "WebhookSubscription.deletedAt":
+		if e.complexity.WebhookSubscription.DeletedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.DeletedAt(childComplexity), true
+
+	case "WebhookSubscription.enabled":
+		if e.complexity.WebhookSubscription.Enabled == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.Enabled(childComplexity), true
+
+	case "WebhookSubscription.eventTypes":
+		if e.complexity.WebhookSubscription.EventTypes == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.EventTypes(childComplexity), true
+
+	case "WebhookSubscription.id":
+		if e.complexity.WebhookSubscription.ID == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.ID(childComplexity), true
+
+	case "WebhookSubscription.name":
+		if e.complexity.WebhookSubscription.Name == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.Name(childComplexity), true
+
+	case "WebhookSubscription.url":
+		if e.complexity.WebhookSubscription.URL == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.URL(childComplexity), true
+
+	case "WebhookSubscription.updatedAt":
+		if e.complexity.WebhookSubscription.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.UpdatedAt(childComplexity), true
+
+	case "WebhookSubscription.updatedBy":
+		if e.complexity.WebhookSubscription.UpdatedBy == nil {
+			break
+		}
+
+		return e.complexity.WebhookSubscription.UpdatedBy(childComplexity), true
+
 	case "_Service.sdl":
 		if e.complexity._Service.SDL == nil {
 			break
@@ -552,17 +1576,31 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAccessPolicyOrder,
+		ec.unmarshalInputAccessPolicyWhereInput,
+		ec.unmarshalInputAuditEventOrder,
+		ec.unmarshalInputAuditEventWhereInput,
+		ec.unmarshalInputCreateAccessPolicyInput,
 		ec.unmarshalInputCreateRoleInput,
 		ec.unmarshalInputCreateUserInput,
+		ec.unmarshalInputCreateWebhookSubscriptionInput,
+		ec.unmarshalInputDecideReq,
+		ec.unmarshalInputGrantRoleReq,
 		ec.unmarshalInputRegisterReq,
 		ec.unmarshalInputRoleOrder,
 		ec.unmarshalInputRoleWhereInput,
+		ec.unmarshalInputUpdateAccessPolicyInput,
 		ec.unmarshalInputUpdateRoleInput,
 		ec.unmarshalInputUpdateUserInput,
+		ec.unmarshalInputUpdateWebhookSubscriptionInput,
 		ec.unmarshalInputUserOrder,
 		ec.unmarshalInputUserRoleOrder,
 		ec.unmarshalInputUserRoleWhereInput,
 		ec.unmarshalInputUserWhereInput,
+		ec.unmarshalInputWebhookDeliveryOrder,
+		ec.unmarshalInputWebhookDeliveryWhereInput,
+		ec.unmarshalInputWebhookSubscriptionOrder,
+		ec.unmarshalInputWebhookSubscriptionWhereInput,
 		ec.unmarshalInputloginReq,
 	)
 	first := true
@@ -594,6 +1632,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -0,0 +1,244 @@
+package graphql
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stark-sim/cas/pkg/ent"
+)
+
+// idPredicate User、Role、UserRole 的 WhereInput 中经由 resolver 把字符串转换为 int64 的过滤条件
+type idPredicate struct {
+	key   string
+	field string
+	list  bool
+}
+
+func idPredicates() []idPredicate {
+	var res []idPredicate
+	for _, prefix := range []struct{ key, field string }{{"id", "ID"}, {"createdBy", "CreatedBy"}, {"updatedBy", "UpdatedBy"}} {
+		for _, suffix := range []struct {
+			key, field string
+			list       bool
+		}{
+			{"", "", false},
+			{"NEQ", "NEQ", false},
+			{"In", "In", true},
+			{"NotIn", "NotIn", true},
+			{"GT", "GT", false},
+			{"GTE", "GTE", false},
+			{"LT", "LT", false},
+			{"LTE", "LTE", false},
+		} {
+			res = append(res, idPredicate{key: prefix.key + suffix.key, field: prefix.field + suffix.field, list: suffix.list})
+		}
+	}
+	return res
+}
+
+func testExecutionContext() *executionContext {
+	return &executionContext{executableSchema: &executableSchema{resolvers: &Resolver{}}}
+}
+
+// unmarshaler 按 GraphQL 变量的形式解析 WhereInput，返回结构体的值
+type unmarshaler func(ctx context.Context, input map[string]interface{}) (interface{}, error)
+
+func whereInputs() map[string]unmarshaler {
+	ec := testExecutionContext()
+	return map[string]unmarshaler{
+		"UserWhereInput": func(ctx context.Context, input map[string]interface{}) (interface{}, error) {
+			return ec.unmarshalInputUserWhereInput(ctx, input)
+		},
+		"RoleWhereInput": func(ctx context.Context, input map[string]interface{}) (interface{}, error) {
+			return ec.unmarshalInputRoleWhereInput(ctx, input)
+		},
+		"UserRoleWhereInput": func(ctx context.Context, input map[string]interface{}) (interface{}, error) {
+			return ec.unmarshalInputUserRoleWhereInput(ctx, input)
+		},
+	}
+}
+
+func TestIDPredicates(t *testing.T) {
+	ctx := context.Background()
+	for typeName, unmarshal := range whereInputs() {
+		for _, p := range idPredicates() {
+			tests := []struct {
+				name    string
+				value   interface{}
+				want    interface{}
+				wantErr bool
+			}{
+				{name: "valid", value: "1614978329427251200", want: int64(1614978329427251200)},
+				{name: "negative", value: "-1", want: int64(-1)},
+				{name: "null", value: nil, want: nil},
+				{name: "empty", value: "", wantErr: true},
+				{name: "malformed", value: "abc", wantErr: true},
+				{name: "overflow", value: "9223372036854775808", wantErr: true},
+			}
+			if p.list {
+				tests = []struct {
+					name    string
+					value   interface{}
+					want    interface{}
+					wantErr bool
+				}{
+					{name: "valid", value: []interface{}{"1", "1614978329427251200"}, want: []int64{1, 1614978329427251200}},
+					{name: "null", value: nil, want: nil},
+					{name: "empty", value: []interface{}{}, want: nil},
+					{name: "malformed", value: []interface{}{"1", "abc"}, wantErr: true},
+				}
+			}
+			for _, tt := range tests {
+				t.Run(typeName+"/"+p.key+"/"+tt.name, func(t *testing.T) {
+					got, err := unmarshal(ctx, map[string]interface{}{p.key: tt.value})
+					if tt.wantErr {
+						if err == nil {
+							t.Fatalf("expected error, got %+v", got)
+						}
+						return
+					}
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					value := reflect.New(reflect.TypeOf(got)).Elem()
+					value.Set(reflect.ValueOf(got))
+					field := value.FieldByName(p.field)
+					if !field.IsValid() {
+						t.Fatalf("%s has no field %s", typeName, p.field)
+					}
+					assertField(t, field, tt.want)
+					// 其他字段不受影响
+					field.Set(reflect.Zero(field.Type()))
+					if !value.IsZero() {
+						t.Errorf("unexpected fields set: %+v", value.Interface())
+					}
+				})
+			}
+		}
+	}
+}
+
+func assertField(t *testing.T, field reflect.Value, want interface{}) {
+	t.Helper()
+	switch want := want.(type) {
+	case nil:
+		if !field.IsZero() {
+			t.Errorf("got %v, want unset", field.Interface())
+		}
+	case int64:
+		got, ok := field.Interface().(*int64)
+		if !ok || got == nil || *got != want {
+			t.Errorf("got %v, want %d", field.Interface(), want)
+		}
+	case []int64:
+		if !reflect.DeepEqual(field.Interface(), want) {
+			t.Errorf("got %v, want %v", field.Interface(), want)
+		}
+	}
+}
+
+func TestNestedWhereInput(t *testing.T) {
+	ctx := context.Background()
+	ec := testExecutionContext()
+	five, seven := int64(5), int64(7)
+	tests := []struct {
+		name    string
+		input   map[string]interface{}
+		want    interface{}
+		wantErr bool
+		parse   func(map[string]interface{}) (interface{}, error)
+	}{
+		{
+			name:  "user hasRolesWith",
+			input: map[string]interface{}{"hasRolesWith": []interface{}{map[string]interface{}{"createdByGT": "5", "updatedByIn": []interface{}{"7"}}}},
+			want:  ent.UserWhereInput{HasRolesWith: []*ent.RoleWhereInput{{CreatedByGT: &five, UpdatedByIn: []int64{7}}}},
+		},
+		{
+			name:    "user hasRolesWith malformed",
+			input:   map[string]interface{}{"hasRolesWith": []interface{}{map[string]interface{}{"id": "x"}}},
+			wantErr: true,
+		},
+		{
+			name: "user hasRolesWith hasUserRolesWith",
+			input: map[string]interface{}{"hasRolesWith": []interface{}{map[string]interface{}{
+				"hasUserRolesWith": []interface{}{map[string]interface{}{"idLTE": "7"}},
+			}}},
+			want: ent.UserWhereInput{HasRolesWith: []*ent.RoleWhereInput{{HasUserRolesWith: []*ent.UserRoleWhereInput{{IDLTE: &seven}}}}},
+		},
+		{
+			name:  "user hasRolesWith empty",
+			input: map[string]interface{}{"hasRolesWith": []interface{}{}},
+			want:  ent.UserWhereInput{HasRolesWith: []*ent.RoleWhereInput{}},
+		},
+		{
+			name:  "user hasUserRolesWith",
+			input: map[string]interface{}{"hasUserRolesWith": []interface{}{map[string]interface{}{"updatedBy": "5"}}},
+			want:  ent.UserWhereInput{HasUserRolesWith: []*ent.UserRoleWhereInput{{UpdatedBy: &five}}},
+		},
+		{
+			name:  "user not and or",
+			input: map[string]interface{}{"not": map[string]interface{}{"id": "5"}, "and": []interface{}{map[string]interface{}{"createdBy": "7"}}, "or": []interface{}{map[string]interface{}{"idGT": "5"}}},
+			want:  ent.UserWhereInput{Not: &ent.UserWhereInput{ID: &five}, And: []*ent.UserWhereInput{{CreatedBy: &seven}}, Or: []*ent.UserWhereInput{{IDGT: &five}}},
+		},
+		{
+			name:    "user not malformed",
+			input:   map[string]interface{}{"not": map[string]interface{}{"updatedByNotIn": []interface{}{"y"}}},
+			wantErr: true,
+		},
+		{
+			name:  "empty",
+			input: map[string]interface{}{},
+			want:  ent.UserWhereInput{},
+		},
+		{
+			name:  "role hasUsersWith",
+			input: map[string]interface{}{"hasUsersWith": []interface{}{map[string]interface{}{"idNEQ": "5"}}},
+			want:  ent.RoleWhereInput{HasUsersWith: []*ent.UserWhereInput{{IDNEQ: &five}}},
+			parse: func(input map[string]interface{}) (interface{}, error) {
+				return ec.unmarshalInputRoleWhereInput(ctx, input)
+			},
+		},
+		{
+			name:    "role hasUserRolesWith malformed",
+			input:   map[string]interface{}{"hasUserRolesWith": []interface{}{map[string]interface{}{"createdByLT": "1.5"}}},
+			wantErr: true,
+			parse: func(input map[string]interface{}) (interface{}, error) {
+				return ec.unmarshalInputRoleWhereInput(ctx, input)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parse := tt.parse
+			if parse == nil {
+				parse = func(input map[string]interface{}) (interface{}, error) {
+					return ec.unmarshalInputUserWhereInput(ctx, input)
+				}
+			}
+			got, err := parse(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNilWhereInputFilter(t *testing.T) {
+	// 未传 where 时 resolver 以 nil 调用 Filter
+	var where *ent.UserWhereInput
+	query := &ent.UserQuery{}
+	got, err := where.Filter(query)
+	if err != nil || got != query {
+		t.Errorf("nil filter should return the query unchanged, got %v, %v", got, err)
+	}
+}
@@ -0,0 +1,25 @@
+package servers
+
+// Permission 调用方法需要访问策略允许的动作与资源类型
+type Permission struct {
+	Action   string
+	Resource string
+}
+
+/*
+Permissions 方法对应的权限，包括全部 WriteMethods、自行开启事务的关系写入与变更订阅
+调用方需在 metadata 的 token 中携带登录凭证，且访问策略允许其执行
+*/
+var Permissions = map[string]Permission{
+	"/pb.UserService/Create":      {Action: "create", Resource: "user"},
+	"/pb.UserService/Update":      {Action: "update", Resource: "user"},
+	"/pb.UserService/Delete":      {Action: "delete", Resource: "user"},
+	"/pb.UserService/BatchCreate": {Action: "create", Resource: "user"},
+	"/pb.RoleService/Create":      {Action: "create", Resource: "role"},
+	"/pb.RoleService/Update":      {Action: "update", Resource: "role"},
+	"/pb.RoleService/Delete":      {Action: "delete", Resource: "role"},
+	"/pb.RoleService/AssignRole":  {Action: "grant", Resource: "role"},
+	"/pb.RoleService/RevokeRole":  {Action: "revoke", Resource: "role"},
+	"/pb.RelationService/Write":   {Action: "write", Resource: "relation"},
+	"/pb.WatchService/Watch":      {Action: "read", Resource: "audit"},
+}
@@ -0,0 +1,22 @@
+package servers
+
+import (
+	"github.com/stark-sim/cas/pkg/ent"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// entError 将 ent 的错误转换为对应的 gRPC 状态码，其余错误原样返回
+func entError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case ent.IsNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	case ent.IsValidationError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case ent.IsConstraintError(err):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return err
+}
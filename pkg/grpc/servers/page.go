@@ -0,0 +1,39 @@
+package servers
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// pageSize 补全并限制分页大小
+func pageSize(size int32) int {
+	if size <= 0 {
+		return DefaultPageSize
+	}
+	if size > MaxPageSize {
+		return MaxPageSize
+	}
+	return int(size)
+}
+
+// 列表按 ID 升序分页，page_token 为上一页最后一条的 ID，为空时从头开始
+func parsePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	lastID, err := strconv.ParseInt(token, 10, 64)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	return lastID, nil
+}
+
+func nextPageToken(lastID int64) string {
+	return strconv.FormatInt(lastID, 10)
+}
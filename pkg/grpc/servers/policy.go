@@ -0,0 +1,30 @@
+package servers
+
+import (
+	"context"
+	"github.com/stark-sim/cas/pkg/abac"
+	"github.com/stark-sim/cas/pkg/grpc/pb"
+)
+
+type PolicyServer struct {
+	Engine *abac.Engine
+}
+
+func (s *PolicyServer) Decide(ctx context.Context, request *__.PolicyDecideRequest) (*__.PolicyDecideResponse, error) {
+	decision, err := s.Engine.Decide(ctx, abac.Request{
+		SubjectID:          request.SubjectId,
+		Action:             request.Action,
+		Resource:           request.Resource,
+		Subject:            request.Subject.AsMap(),
+		ResourceAttributes: request.ResourceAttributes.AsMap(),
+		Environment:        request.Environment.AsMap(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &__.PolicyDecideResponse{
+		Allowed:   decision.Allowed,
+		Effect:    string(decision.Effect),
+		PolicyIds: decision.PolicyIDs,
+	}, nil
+}
@@ -0,0 +1,111 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"github.com/stark-sim/cas/pkg/grpc/pb"
+	"github.com/stark-sim/cas/pkg/rbac"
+	"github.com/stark-sim/cas/pkg/rebac"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RelationServer struct {
+	Engine *rebac.Engine
+}
+
+func (s *RelationServer) Check(ctx context.Context, request *__.RelationCheckRequest) (*__.RelationCheckResponse, error) {
+	if request.Tuple == nil {
+		return nil, status.Error(codes.InvalidArgument, "tuple is required")
+	}
+	allowed, token, err := s.Engine.Check(ctx, tupleFromPb(request.Tuple), request.ConsistencyToken)
+	if err != nil {
+		return nil, relationError(err)
+	}
+	return &__.RelationCheckResponse{Allowed: allowed, ConsistencyToken: token}, nil
+}
+
+func (s *RelationServer) Expand(ctx context.Context, request *__.RelationExpandRequest) (*__.RelationExpandResponse, error) {
+	tree, token, err := s.Engine.Expand(ctx, request.Namespace, request.ObjectId, request.Relation, request.ConsistencyToken)
+	if err != nil {
+		return nil, relationError(err)
+	}
+	return &__.RelationExpandResponse{Tree: treeToPb(tree), ConsistencyToken: token}, nil
+}
+
+func (s *RelationServer) Write(ctx context.Context, request *__.RelationWriteRequest) (*__.RelationWriteResponse, error) {
+	updates := make([]rebac.Update, 0, len(request.Updates))
+	for _, v := range request.Updates {
+		if v.Tuple == nil {
+			return nil, status.Error(codes.InvalidArgument, "tuple is required")
+		}
+		update := rebac.Update{Operation: rebac.Touch, Tuple: tupleFromPb(v.Tuple)}
+		if v.Operation == __.RelationTupleUpdate_DELETE {
+			update.Operation = rebac.Delete
+		}
+		updates = append(updates, update)
+	}
+	token, err := s.Engine.Write(ctx, updates)
+	if err != nil {
+		return nil, relationError(err)
+	}
+	return &__.RelationWriteResponse{ConsistencyToken: token}, nil
+}
+
+func (s *RelationServer) ListObjects(ctx context.Context, request *__.RelationListObjectsRequest) (*__.RelationListObjectsResponse, error) {
+	if request.Subject == nil {
+		return nil, status.Error(codes.InvalidArgument, "subject is required")
+	}
+	objectIDs, token, err := s.Engine.ListObjects(ctx, request.Namespace, request.Relation, subjectFromPb(request.Subject), request.ConsistencyToken)
+	if err != nil {
+		return nil, relationError(err)
+	}
+	return &__.RelationListObjectsResponse{ObjectIds: objectIDs, ConsistencyToken: token}, nil
+}
+
+// relationError 将 rebac 的错误转换为对应的 gRPC 状态码
+func relationError(err error) error {
+	switch {
+	case errors.Is(err, rebac.ErrInvalidTuple), errors.Is(err, rebac.ErrUnknownRelation),
+		errors.Is(err, rebac.ErrInvalidToken), errors.Is(err, rbac.ErrInvalidWindow):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, rebac.ErrMaxDepth):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, rbac.ErrUnknownGrant):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return entError(err)
+}
+
+func subjectFromPb(subject *__.RelationSubject) rebac.Subject {
+	return rebac.Subject{Namespace: subject.GetNamespace(), ID: subject.GetId(), Relation: subject.GetRelation()}
+}
+
+func subjectToPb(subject rebac.Subject) *__.RelationSubject {
+	return &__.RelationSubject{Namespace: subject.Namespace, Id: subject.ID, Relation: subject.Relation}
+}
+
+func tupleFromPb(tuple *__.RelationTuple) rebac.Tuple {
+	return rebac.Tuple{
+		Namespace: tuple.Namespace,
+		ObjectID:  tuple.ObjectId,
+		Relation:  tuple.Relation,
+		Subject:   subjectFromPb(tuple.Subject),
+	}
+}
+
+func treeToPb(tree *rebac.Tree) *__.RelationTree {
+	res := &__.RelationTree{
+		Operation: tree.Operation,
+		Namespace: tree.Namespace,
+		ObjectId:  tree.ObjectID,
+		Relation:  tree.Relation,
+	}
+	for _, v := range tree.Subjects {
+		res.Subjects = append(res.Subjects, subjectToPb(v))
+	}
+	for _, v := range tree.Children {
+		res.Children = append(res.Children, treeToPb(v))
+	}
+	return res
+}
@@ -0,0 +1,211 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/predicate"
+	"github.com/stark-sim/cas/pkg/ent/role"
+	"github.com/stark-sim/cas/pkg/ent/user"
+	"github.com/stark-sim/cas/pkg/grpc/pb"
+	"github.com/stark-sim/cas/pkg/rbac"
+	"github.com/stark-sim/cas/tools"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RoleServer struct {
+	Client *ent.Client
+}
+
+func (s *RoleServer) Create(ctx context.Context, request *__.RoleCreateRequest) (*__.Role, error) {
+	if request.Role == nil {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+	_role, err := clientFrom(ctx, s.Client).Role.Create().SetName(request.Role.Name).Save(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return roleToPb(_role), nil
+}
+
+func (s *RoleServer) Get(ctx context.Context, request *__.RoleGetRequest) (*__.Role, error) {
+	_role, err := s.Client.Role.Get(ctx, request.Id)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return roleToPb(_role), nil
+}
+
+// Update 只更新 update_mask 中的字段
+func (s *RoleServer) Update(ctx context.Context, request *__.RoleUpdateRequest) (*__.Role, error) {
+	if request.Role == nil {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+	paths := []string{role.FieldName}
+	if len(request.UpdateMask.GetPaths()) > 0 {
+		paths = request.UpdateMask.GetPaths()
+	}
+	client := clientFrom(ctx, s.Client)
+	// UpdateOneID 不经过软删除的过滤，已删除的角色按不存在处理
+	if _, err := client.Role.Get(ctx, request.Role.Id); err != nil {
+		return nil, entError(err)
+	}
+	update := client.Role.UpdateOneID(request.Role.Id)
+	for _, path := range paths {
+		switch path {
+		case role.FieldName:
+			update.SetName(request.Role.Name)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "field %s can not be updated", path)
+		}
+	}
+	_role, err := update.Save(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return roleToPb(_role), nil
+}
+
+// Delete 软删除，角色的授权一并删除
+func (s *RoleServer) Delete(ctx context.Context, request *__.RoleDeleteRequest) (*emptypb.Empty, error) {
+	if _, err := rbac.DeleteRole(ctx, clientFrom(ctx, s.Client), request.Id); err != nil {
+		return nil, entError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RoleServer) List(ctx context.Context, request *__.RoleListRequest) (*__.RoleListResponse, error) {
+	lastID, err := parsePageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	predicates := []predicate.Role{role.IDGT(lastID)}
+	if request.NameContains != "" {
+		predicates = append(predicates, role.NameContains(request.NameContains))
+	}
+	roles, token, err := listRoles(ctx, s.Client.Role.Query().Where(predicates...), pageSize(request.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return &__.RoleListResponse{RoleList: roles, NextPageToken: token}, nil
+}
+
+// AssignRole 幂等，user_id, role_id 上的唯一索引保证只有一条授权
+func (s *RoleServer) AssignRole(ctx context.Context, request *__.AssignRoleRequest) (*__.RoleAssignment, error) {
+	validFrom, validUntil := tools.ZeroTime, tools.ZeroTime
+	if request.ValidFrom != nil {
+		validFrom = request.ValidFrom.AsTime()
+	}
+	if request.ValidUntil != nil {
+		validUntil = request.ValidUntil.AsTime()
+	}
+	userRole, err := rbac.Grant(ctx, clientFrom(ctx, s.Client), request.UserId, request.RoleId, validFrom, validUntil)
+	switch {
+	case errors.Is(err, rbac.ErrInvalidWindow):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, rbac.ErrUnknownGrant):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, entError(err)
+	}
+	return userRoleToPb(userRole), nil
+}
+
+// RevokeRole 幂等，未授权时同样成功
+func (s *RoleServer) RevokeRole(ctx context.Context, request *__.RevokeRoleRequest) (*emptypb.Empty, error) {
+	if err := rbac.Revoke(ctx, clientFrom(ctx, s.Client), request.UserId, request.RoleId); err != nil {
+		return nil, entError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListUserRoles 用户当前生效的角色
+func (s *RoleServer) ListUserRoles(ctx context.Context, request *__.ListUserRolesRequest) (*__.ListUserRolesResponse, error) {
+	lastID, err := parsePageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	query := rbac.UserRolesQuery(s.Client, request.UserId).Where(role.IDGT(lastID))
+	roles, token, err := listRoles(ctx, query, pageSize(request.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	return &__.ListUserRolesResponse{Roles: roles, NextPageToken: token}, nil
+}
+
+// ListRoleMembers 当前拥有该角色的用户
+func (s *RoleServer) ListRoleMembers(ctx context.Context, request *__.ListRoleMembersRequest) (*__.ListRoleMembersResponse, error) {
+	lastID, err := parsePageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	size := pageSize(request.PageSize)
+	users, err := rbac.RoleUsersQuery(s.Client, request.RoleId).
+		Where(user.IDGT(lastID)).
+		Order(ent.Asc(user.FieldID)).
+		Limit(size + 1).
+		All(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	res := &__.ListRoleMembersResponse{}
+	if len(users) > size {
+		users = users[:size]
+		res.NextPageToken = nextPageToken(users[size-1].ID)
+	}
+	res.Users = make([]*__.User, 0, len(users))
+	for _, v := range users {
+		res.Users = append(res.Users, userToPb(v))
+	}
+	return res, nil
+}
+
+// listRoles 多取一个判断是否还有下一页
+func listRoles(ctx context.Context, query *ent.RoleQuery, size int) ([]*__.Role, string, error) {
+	roles, err := query.Order(ent.Asc(role.FieldID)).Limit(size + 1).All(ctx)
+	if err != nil {
+		return nil, "", entError(err)
+	}
+	token := ""
+	if len(roles) > size {
+		roles = roles[:size]
+		token = nextPageToken(roles[size-1].ID)
+	}
+	res := make([]*__.Role, 0, len(roles))
+	for _, v := range roles {
+		res = append(res, roleToPb(v))
+	}
+	return res, token, nil
+}
+
+func roleToPb(_role *ent.Role) *__.Role {
+	return &__.Role{
+		Id:        _role.ID,
+		Name:      _role.Name,
+		CreatedBy: _role.CreatedBy,
+		UpdatedBy: _role.UpdatedBy,
+		CreatedAt: timestamppb.New(_role.CreatedAt),
+		UpdatedAt: timestamppb.New(_role.UpdatedAt),
+	}
+}
+
+// 零值有效期表示不限，不返回
+func userRoleToPb(userRole *ent.UserRole) *__.RoleAssignment {
+	res := &__.RoleAssignment{
+		Id:        userRole.ID,
+		UserId:    userRole.UserID,
+		RoleId:    userRole.RoleID,
+		CreatedAt: timestamppb.New(userRole.CreatedAt),
+		UpdatedAt: timestamppb.New(userRole.UpdatedAt),
+	}
+	if !userRole.ValidFrom.IsZero() {
+		res.ValidFrom = timestamppb.New(userRole.ValidFrom)
+	}
+	if !userRole.ValidUntil.IsZero() {
+		res.ValidUntil = timestamppb.New(userRole.ValidUntil)
+	}
+	return res
+}
@@ -0,0 +1,37 @@
+package servers
+
+import (
+	"context"
+	"github.com/stark-sim/cas/pkg/ent"
+)
+
+// WriteMethods 需要在事务中执行的方法，审计日志与 outbox 事件随变更一并提交
+var WriteMethods = []string{
+	"/pb.UserService/Create",
+	"/pb.UserService/Update",
+	"/pb.UserService/Delete",
+	"/pb.UserService/BatchCreate",
+	"/pb.RoleService/Create",
+	"/pb.RoleService/Update",
+	"/pb.RoleService/Delete",
+	"/pb.RoleService/AssignRole",
+	"/pb.RoleService/RevokeRole",
+}
+
+// ReadMethods 只读的方法，查询可以发往只读副本
+var ReadMethods = []string{
+	"/pb.UserService/Get",
+	"/pb.UserService/List",
+	"/pb.RoleService/Get",
+	"/pb.RoleService/List",
+	"/pb.RoleService/ListUserRoles",
+	"/pb.RoleService/ListRoleMembers",
+}
+
+// clientFrom 优先使用拦截器为 WriteMethods 开启的事务
+func clientFrom(ctx context.Context, client *ent.Client) *ent.Client {
+	if c := ent.FromContext(ctx); c != nil {
+		return c
+	}
+	return client
+}
@@ -3,23 +3,162 @@ package servers
 import (
 	"context"
 	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/predicate"
 	"github.com/stark-sim/cas/pkg/ent/user"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
 	"github.com/stark-sim/cas/pkg/grpc/pb"
-	"github.com/stark-sim/cas/tools"
+	"github.com/stark-sim/cas/pkg/metrics"
+	"github.com/stark-sim/cas/pkg/rbac"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 type UserServer struct {
 	Client *ent.Client
 }
 
+func (s *UserServer) Create(ctx context.Context, request *__.UserCreateRequest) (*__.User, error) {
+	if request.User.GetPhone() == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone is required")
+	}
+	_user, err := clientFrom(ctx, s.Client).User.Create().SetName(request.User.Name).SetPhone(request.User.Phone).Save(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	rbac.AfterCommit(ctx, metrics.Registration)
+	return userToPb(_user), nil
+}
+
 func (s *UserServer) Get(ctx context.Context, request *__.UserGetRequest) (*__.User, error) {
-	_user, err := s.Client.User.Query().Where(user.ID(request.Id), user.DeletedAt(tools.ZeroTime)).First(ctx)
+	_user, err := s.Client.User.Query().Where(user.ID(request.Id)).First(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return userToPb(_user), nil
+}
+
+// Update 只更新 update_mask 中的字段
+func (s *UserServer) Update(ctx context.Context, request *__.UserUpdateRequest) (*__.User, error) {
+	if request.User == nil {
+		return nil, status.Error(codes.InvalidArgument, "user is required")
+	}
+	paths := []string{user.FieldName, user.FieldPhone}
+	if len(request.UpdateMask.GetPaths()) > 0 {
+		paths = request.UpdateMask.GetPaths()
+	}
+	client := clientFrom(ctx, s.Client)
+	// UpdateOneID 不经过软删除的过滤，已删除的用户按不存在处理
+	if _, err := client.User.Get(ctx, request.User.Id); err != nil {
+		return nil, entError(err)
+	}
+	update := client.User.UpdateOneID(request.User.Id)
+	for _, path := range paths {
+		switch path {
+		case user.FieldName:
+			update.SetName(request.User.Name)
+		case user.FieldPhone:
+			if request.User.Phone == "" {
+				return nil, status.Error(codes.InvalidArgument, "phone is required")
+			}
+			update.SetPhone(request.User.Phone)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "field %s can not be updated", path)
+		}
+	}
+	_user, err := update.Save(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	return userToPb(_user), nil
+}
+
+// Delete 软删除，用户的授权一并删除
+func (s *UserServer) Delete(ctx context.Context, request *__.UserDeleteRequest) (*emptypb.Empty, error) {
+	if _, err := rbac.DeleteUser(ctx, clientFrom(ctx, s.Client), request.Id); err != nil {
+		return nil, entError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserServer) List(ctx context.Context, request *__.UserListRequest) (*__.UserListResponse, error) {
+	size := pageSize(request.PageSize)
+	lastID, err := parsePageToken(request.PageToken)
 	if err != nil {
 		return nil, err
 	}
+	predicates := []predicate.User{user.IDGT(lastID)}
+	if request.NameContains != "" {
+		predicates = append(predicates, user.NameContains(request.NameContains))
+	}
+	if request.Phone != "" {
+		predicates = append(predicates, user.Phone(request.Phone))
+	}
+	if request.RoleId != 0 {
+		predicates = append(predicates, user.HasUserRolesWith(userrole.RoleID(request.RoleId), rbac.ActiveGrant(time.Now())))
+	}
+	if request.CreatedAfter != nil {
+		predicates = append(predicates, user.CreatedAtGTE(request.CreatedAfter.AsTime()))
+	}
+	if request.CreatedBefore != nil {
+		predicates = append(predicates, user.CreatedAtLT(request.CreatedBefore.AsTime()))
+	}
+	// 多取一个判断是否还有下一页
+	users, err := s.Client.User.Query().
+		Where(predicates...).
+		Order(ent.Asc(user.FieldID)).
+		Limit(size + 1).
+		All(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	res := &__.UserListResponse{}
+	if len(users) > size {
+		users = users[:size]
+		res.NextPageToken = nextPageToken(users[size-1].ID)
+	}
+	res.UserList = make([]*__.User, 0, len(users))
+	for _, v := range users {
+		res.UserList = append(res.UserList, userToPb(v))
+	}
+	return res, nil
+}
+
+// BatchCreate 一条语句插入，全部成功或全部失败
+func (s *UserServer) BatchCreate(ctx context.Context, request *__.UserBatchCreateRequest) (*__.UserBatchCreateResponse, error) {
+	if len(request.Requests) > MaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d users can be created at once", MaxPageSize)
+	}
+	client := clientFrom(ctx, s.Client)
+	builders := make([]*ent.UserCreate, 0, len(request.Requests))
+	for _, v := range request.Requests {
+		if v.GetUser().GetPhone() == "" {
+			return nil, status.Error(codes.InvalidArgument, "phone is required")
+		}
+		builders = append(builders, client.User.Create().SetName(v.User.Name).SetPhone(v.User.Phone))
+	}
+	users, err := client.User.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, entError(err)
+	}
+	res := &__.UserBatchCreateResponse{Users: make([]*__.User, 0, len(users))}
+	for _, v := range users {
+		rbac.AfterCommit(ctx, metrics.Registration)
+		res.Users = append(res.Users, userToPb(v))
+	}
+	return res, nil
+}
+
+func userToPb(_user *ent.User) *__.User {
 	return &__.User{
-		Id:    _user.ID,
-		Name:  _user.Name,
-		Phone: _user.Phone,
-	}, err
+		Id:        _user.ID,
+		Name:      _user.Name,
+		Phone:     _user.Phone,
+		CreatedBy: _user.CreatedBy,
+		UpdatedBy: _user.UpdatedBy,
+		CreatedAt: timestamppb.New(_user.CreatedAt),
+		UpdatedAt: timestamppb.New(_user.UpdatedAt),
+	}
 }
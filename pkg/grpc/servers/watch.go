@@ -0,0 +1,58 @@
+package servers
+
+import (
+	"errors"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/auditevent"
+	"github.com/stark-sim/cas/pkg/grpc/pb"
+	"github.com/stark-sim/cas/pkg/watch"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WatchServer struct {
+	Client *ent.Client
+	Broker *watch.Broker
+}
+
+var watchOperations = map[auditevent.Operation]__.WatchEvent_Operation{
+	auditevent.OperationCreate: __.WatchEvent_CREATE,
+	auditevent.OperationUpdate: __.WatchEvent_UPDATE,
+	auditevent.OperationDelete: __.WatchEvent_DELETE,
+	auditevent.OperationPurge:  __.WatchEvent_PURGE,
+}
+
+/*
+Watch 推送 User、Role、UserRole 的变更，直到客户端断开
+断线或服务重启后，客户端带上最后收到的 resume_token 重新调用即可续传
+*/
+func (s *WatchServer) Watch(request *__.WatchRequest, stream __.WatchService_WatchServer) error {
+	err := watch.Stream(stream.Context(), s.Client, s.Broker, watch.Options{
+		Types:       request.EntityTypes,
+		ResumeToken: request.ResumeToken,
+	}, func(event *ent.AuditEvent) error {
+		changes, err := structpb.NewStruct(event.Changes)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed at encoding changes of audit event %d: %v", event.ID, err)
+		}
+		return stream.Send(&__.WatchEvent{
+			Operation:   watchOperations[event.Operation],
+			EntityType:  event.EntityType,
+			EntityId:    event.EntityID,
+			ActorId:     event.ActorID,
+			Changes:     changes,
+			OccurredAt:  timestamppb.New(event.CreatedAt),
+			ResumeToken: watch.Token(event),
+		})
+	})
+	switch {
+	case errors.Is(err, watch.ErrInvalidToken), errors.Is(err, watch.ErrUnknownType):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case stream.Context().Err() != nil:
+		// 客户端断开
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var (
+	graphqlDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "operation_duration_seconds",
+		Help:      "GraphQL query and mutation latency by operation type and root field.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"type", "field"})
+	graphqlErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "errors_total",
+		Help:      "GraphQL errors by operation type, root field and error code.",
+	}, []string{"type", "field", "code"})
+)
+
+const (
+	// 没有通过解析或校验的请求
+	unknownOperation = "unknown"
+	// 一次请求包含多个根字段
+	multipleFields = "multiple"
+	// 解析器返回的错误没有 code 扩展
+	resolverError = "RESOLVER_ERROR"
+)
+
+/*
+GraphQL gqlgen 扩展，按操作类型与根字段记录延迟与错误
+客户端指定的操作名不可信，不作为标签；根字段已经过 schema 校验，取值有限
+订阅的每次推送不记录延迟，只记录错误
+*/
+type GraphQL struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = GraphQL{}
+
+func (GraphQL) ExtensionName() string {
+	return "Metrics"
+}
+
+func (GraphQL) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	start := time.Now()
+	resp := next(ctx)
+	opType, field := unknownOperation, unknownOperation
+	if graphql.HasOperationContext(ctx) {
+		oc := graphql.GetOperationContext(ctx)
+		if !oc.Stats.OperationStart.IsZero() {
+			start = oc.Stats.OperationStart
+		}
+		if oc.Operation != nil {
+			opType, field = string(oc.Operation.Operation), rootField(oc.Operation.SelectionSet)
+		}
+	}
+	if opType != string(ast.Subscription) {
+		graphqlDuration.WithLabelValues(opType, field).Observe(time.Since(start).Seconds())
+	}
+	if resp != nil {
+		for _, err := range resp.Errors {
+			code, ok := err.Extensions["code"].(string)
+			if !ok || code == "" {
+				code = resolverError
+			}
+			graphqlErrors.WithLabelValues(opType, field, code).Inc()
+		}
+	}
+	return resp
+}
+
+// rootField 取根字段名而不是别名，别名由客户端指定
+func rootField(set ast.SelectionSet) string {
+	if len(set) > 1 {
+		return multipleFields
+	}
+	if len(set) == 1 {
+		if f, ok := set[0].(*ast.Field); ok {
+			return f.Name
+		}
+	}
+	return unknownOperation
+}
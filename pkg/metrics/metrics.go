@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+/*
+指标统一以 cas_ 为前缀，标签值只取自服务端定义的有限集合（schema 中的字段、注册的 gRPC 方法、路由模板等），
+不直接使用客户端传入的操作名、路径等，避免时间序列无限增长
+*/
+const namespace = "cas"
+
+// Registry 本服务的指标，不使用全局的 DefaultRegisterer，避免依赖库注册的指标混入
+var Registry = prometheus.NewRegistry()
+
+var (
+	logins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logins_total",
+		Help:      "Login attempts by result.",
+	}, []string{"result"})
+	registrations = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "registrations_total",
+		Help:      "Users registered.",
+	})
+	tokenRefreshes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "token_refreshes_total",
+		Help:      "Access tokens refreshed.",
+	})
+	roleGrants = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "role_grants_total",
+		Help:      "Roles granted to users.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		logins, registrations, tokenRefreshes, roleGrants,
+		httpRequests, httpDuration,
+		graphqlDuration, graphqlErrors,
+		grpcRequests, grpcDuration,
+	)
+	// 预先创建标签组合，没有发生过的事件也输出 0
+	logins.WithLabelValues("success")
+	logins.WithLabelValues("failure")
+}
+
+// Handler 以 Prometheus 文本格式输出 Registry 中的指标
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+/*
+RegisterDB 输出连接池的统计，name 区分不同的库
+同一 name 重复注册时保留先注册的连接池
+*/
+func RegisterDB(db *sql.DB, name string) error {
+	err := Registry.Register(collectors.NewDBStatsCollector(db, name))
+	if errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+		return nil
+	}
+	return err
+}
+
+// Login 记录一次登录，success 为是否登录成功
+func Login(success bool) {
+	if success {
+		logins.WithLabelValues("success").Inc()
+	} else {
+		logins.WithLabelValues("failure").Inc()
+	}
+}
+
+func Registration() {
+	registrations.Inc()
+}
+
+func TokenRefresh() {
+	tokenRefreshes.Inc()
+}
+
+func RoleGrant() {
+	roleGrants.Inc()
+}
@@ -0,0 +1,228 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/hook"
+	"github.com/stark-sim/cas/pkg/ent/outboxevent"
+	"github.com/stark-sim/cas/pkg/ent/role"
+	"github.com/stark-sim/cas/pkg/ent/schema"
+	"github.com/stark-sim/cas/pkg/ent/user"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/tools"
+)
+
+// 事件类型
+const (
+	UserRegistered = "user.registered"
+	UserUpdated    = "user.updated"
+	UserDeleted    = "user.deleted"
+	UserRestored   = "user.restored"
+	UserPurged     = "user.purged"
+	RoleCreated    = "role.created"
+	RoleUpdated    = "role.updated"
+	RoleDeleted    = "role.deleted"
+	RoleRestored   = "role.restored"
+	RolePurged     = "role.purged"
+	RoleAssigned   = "role.assigned"
+	RoleRevoked    = "role.revoked"
+)
+
+const deletedAtField = "deleted_at"
+
+// 产生事件的实体
+var eventTypes = map[string]map[action]string{
+	ent.TypeUser: {
+		actionCreate:  UserRegistered,
+		actionUpdate:  UserUpdated,
+		actionDelete:  UserDeleted,
+		actionRestore: UserRestored,
+		actionPurge:   UserPurged,
+	},
+	ent.TypeRole: {
+		actionCreate:  RoleCreated,
+		actionUpdate:  RoleUpdated,
+		actionDelete:  RoleDeleted,
+		actionRestore: RoleRestored,
+		actionPurge:   RolePurged,
+	},
+	// 授权的物理删除只发生在用户或角色被彻底删除时，由 user.purged、role.purged 表达
+	ent.TypeUserRole: {
+		actionCreate:  RoleAssigned,
+		actionUpdate:  RoleAssigned,
+		actionRestore: RoleAssigned,
+		actionDelete:  RoleRevoked,
+	},
+}
+
+type action int
+
+const (
+	actionCreate action = iota
+	actionUpdate
+	actionDelete
+	actionRestore
+	actionPurge
+)
+
+/*
+Register 为 client 注册 outbox hook，事件与变更写在同一事务中
+变更需在事务中执行，才能保证事件与变更一并提交或回滚
+*/
+func Register(client *ent.Client) {
+	client.Use(hook.If(Hook(), hook.Condition(func(_ context.Context, m ent.Mutation) bool {
+		_, ok := eventTypes[m.Type()]
+		return ok
+	})))
+}
+
+// Hook 每次变更后按实体写入一条 OutboxEvent
+func Hook() ent.Hook {
+	return schema.RecordHook(func(ctx context.Context, m schema.Mutation) func(context.Context, []int64) error {
+		eventType, ok := eventTypes[m.Type()][actionOf(m)]
+		if !ok {
+			return nil
+		}
+		return func(ctx context.Context, ids []int64) error {
+			builders, err := newEvents(ctx, m, eventType, ids)
+			if err != nil {
+				return err
+			}
+			if err = assignIDs(ctx, m.Client(), builders); err != nil {
+				return fmt.Errorf("failed at locking outbox aggregates: %w", err)
+			}
+			if err = m.Client().OutboxEvent.CreateBulk(builders...).Exec(ctx); err != nil {
+				return fmt.Errorf("failed at writing outbox events: %w", err)
+			}
+			return nil
+		}
+	})
+}
+
+// actionOf 软删除表现为把 deleted_at 更新为非零值，恢复为更新为零值
+func actionOf(m ent.Mutation) action {
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		return actionCreate
+	case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		return actionPurge
+	}
+	if v, ok := m.Field(deletedAtField); ok {
+		if t, ok := v.(time.Time); ok {
+			if t.Equal(tools.ZeroTime) {
+				return actionRestore
+			}
+			return actionDelete
+		}
+	}
+	return actionUpdate
+}
+
+/*
+newEvents 用户与角色的事件以自身为聚合，载荷为 id 与变更后的字段
+授权的事件以用户为聚合，保证同一用户的注册、授权、删除按顺序发布
+*/
+func newEvents(ctx context.Context, m schema.Mutation, eventType string, ids []int64) ([]*ent.OutboxEventCreate, error) {
+	client := m.Client()
+	builders := make([]*ent.OutboxEventCreate, 0, len(ids))
+	if m.Type() == ent.TypeUserRole {
+		// 批量更新时只有 ID，授权的用户与角色需要重新读取
+		userRoles, err := client.UserRole.Query().Where(userrole.IDIn(ids...)).All(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range userRoles {
+			payload, err := json.Marshal(map[string]interface{}{
+				"id":          v.ID,
+				"user_id":     v.UserID,
+				"role_id":     v.RoleID,
+				"valid_from":  v.ValidFrom,
+				"valid_until": v.ValidUntil,
+			})
+			if err != nil {
+				return nil, err
+			}
+			builders = append(builders, client.OutboxEvent.Create().
+				SetEventType(eventType).
+				SetAggregateType(ent.TypeUser).
+				SetAggregateID(v.UserID).
+				SetPayload(payload))
+		}
+		return builders, nil
+	}
+	fields := make(map[string]interface{})
+	for _, f := range m.Fields() {
+		fields[f], _ = m.Field(f)
+	}
+	for _, id := range ids {
+		payload, err := json.Marshal(map[string]interface{}{"id": id, "fields": fields})
+		if err != nil {
+			return nil, err
+		}
+		builders = append(builders, client.OutboxEvent.Create().
+			SetEventType(eventType).
+			SetAggregateType(m.Type()).
+			SetAggregateID(id).
+			SetPayload(payload))
+	}
+	return builders, nil
+}
+
+/*
+assignIDs 锁定聚合所在的行后再生成事件的 ID，同一聚合的事件 ID 随提交顺序递增，relay 按 ID 发布即按提交顺序
+各实例时钟不一致时 snowflake ID 不随提交顺序递增，不大于该聚合已有事件的 ID 时取最大 ID 加一
+*/
+func assignIDs(ctx context.Context, client *ent.Client, builders []*ent.OutboxEventCreate) error {
+	last := make(map[string]int64)
+	for _, b := range builders {
+		aggregateType, _ := b.Mutation().AggregateType()
+		aggregateID, _ := b.Mutation().AggregateID()
+		aggregate := fmt.Sprintf("%s:%d", aggregateType, aggregateID)
+		latest, ok := last[aggregate]
+		if !ok {
+			if err := lockAggregate(ctx, client, aggregateType, aggregateID); err != nil {
+				return err
+			}
+			// 加锁读取，等锁期间其他事务提交的事件也能读到
+			ids, err := client.OutboxEvent.Query().
+				Where(outboxevent.AggregateType(aggregateType), outboxevent.AggregateID(aggregateID), tools.ForUpdate).
+				Order(ent.Desc(outboxevent.FieldID)).
+				Limit(1).
+				Unique(false).
+				IDs(ctx)
+			if err != nil {
+				return err
+			}
+			if len(ids) > 0 {
+				latest = ids[0]
+			}
+		}
+		id := tools.GenSnowflakeID()
+		if id <= latest {
+			id = latest + 1
+		}
+		b.SetID(id)
+		last[aggregate] = id
+	}
+	return nil
+}
+
+/*
+lockAggregate 给聚合所在的行加锁，同一聚合的事件按提交顺序串行写入
+变更本身已锁定该行时不会等待，授权的事件以用户为聚合，需要锁定用户
+*/
+func lockAggregate(ctx context.Context, client *ent.Client, aggregateType string, aggregateID int64) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	var err error
+	switch aggregateType {
+	case ent.TypeUser:
+		_, err = client.User.Query().Where(user.ID(aggregateID), tools.ForUpdate).Unique(false).IDs(ctx)
+	case ent.TypeRole:
+		_, err = client.Role.Query().Where(role.ID(aggregateID), tools.ForUpdate).Unique(false).IDs(ctx)
+	}
+	return err
+}
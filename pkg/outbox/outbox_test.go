@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/outboxevent"
+	"github.com/stark-sim/cas/pkg/ent/schema"
+	"github.com/stark-sim/cas/tools"
+)
+
+func TestHook(t *testing.T) {
+	ctx := context.Background()
+	client := openClient(t)
+	Register(client)
+	u := client.User.Create().SetPhone("13800000000").SaveX(ctx)
+	client.User.UpdateOne(u).SetName("alice").ExecX(ctx)
+	// 软删除转换为更新后只记录一次
+	client.User.DeleteOne(u).ExecX(ctx)
+	client.User.UpdateOneID(u.ID).SetDeletedAt(u.DeletedAt).ExecX(schema.SkipSoftDelete(ctx))
+	client.User.DeleteOneID(u.ID).ExecX(schema.SkipSoftDelete(ctx))
+	// 没有命中的变更不产生事件
+	client.User.Update().SetName("bob").ExecX(ctx)
+	var got []string
+	for _, v := range client.OutboxEvent.Query().Order(ent.Asc(outboxevent.FieldID)).AllX(ctx) {
+		if v.AggregateType != ent.TypeUser || v.AggregateID != u.ID {
+			t.Errorf("event %s of %s:%d", v.EventType, v.AggregateType, v.AggregateID)
+		}
+		got = append(got, v.EventType)
+	}
+	want := []string{UserRegistered, UserUpdated, UserDeleted, UserRestored, UserPurged}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestHookOrdersAggregateWithSkewedClock(t *testing.T) {
+	ctx := context.Background()
+	client := openClient(t)
+	Register(client)
+	u := client.User.Create().SetPhone("13800000000").SaveX(ctx)
+	r := client.Role.Create().SetName("editor").SaveX(ctx)
+	// 时钟偏快的实例先写入的事件
+	skewed := client.OutboxEvent.Create().
+		SetID(tools.SnowflakeIDAt(time.Now().Add(time.Hour))).
+		SetEventType(UserUpdated).
+		SetAggregateType(ent.TypeUser).
+		SetAggregateID(u.ID).
+		SaveX(ctx)
+	client.User.UpdateOne(u).SetName("alice").ExecX(ctx)
+	client.UserRole.Create().SetUserID(u.ID).SetRoleID(r.ID).ExecX(ctx)
+	events := client.OutboxEvent.Query().
+		Where(outboxevent.AggregateType(ent.TypeUser), outboxevent.AggregateID(u.ID)).
+		Order(ent.Asc(outboxevent.FieldID)).
+		AllX(ctx)
+	var got []string
+	for _, v := range events {
+		got = append(got, v.EventType)
+	}
+	// 之后提交的事件 ID 更大
+	want := []string{UserRegistered, UserUpdated, UserUpdated, RoleAssigned}
+	if !reflect.DeepEqual(got, want) || events[1].ID != skewed.ID {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	// 其他聚合不受影响
+	if event := client.OutboxEvent.Query().Where(outboxevent.AggregateType(ent.TypeRole)).OnlyX(ctx); event.ID >= skewed.ID {
+		t.Errorf("role event %d after skewed event %d", event.ID, skewed.ID)
+	}
+}
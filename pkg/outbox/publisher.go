@@ -0,0 +1,97 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/stark-sim/cas/pkg/ent"
+)
+
+// Publisher 将事件发布到消息总线，返回 nil 表示消息总线已确认收到
+type Publisher interface {
+	Publish(ctx context.Context, message Message) error
+}
+
+/*
+Message 发布的事件格式
+投递至少一次，消费方需按 ID 去重；同一聚合的事件按 ID 顺序投递
+*/
+type Message struct {
+	ID            int64           `json:"id,string"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   int64           `json:"aggregate_id,string"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+}
+
+func NewMessage(event *ent.OutboxEvent) Message {
+	return Message{
+		ID:            event.ID,
+		Type:          event.EventType,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		Payload:       event.Payload,
+		OccurredAt:    event.CreatedAt,
+	}
+}
+
+// Publishers 依次发布，任一失败时整体重试，之前成功的会再次收到
+type Publishers []Publisher
+
+func (p Publishers) Publish(ctx context.Context, message Message) error {
+	for _, v := range p {
+		if err := v.Publish(ctx, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MemoryPublisher 保存在进程内，供测试使用
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func (p *MemoryPublisher) Publish(_ context.Context, message Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+// Messages 已发布的事件，按发布顺序
+func (p *MemoryPublisher) Messages() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Message(nil), p.messages...)
+}
+
+// FilePublisher 以 JSON Lines 追加写入文件，供本地调试与测试使用
+type FilePublisher struct {
+	Path string
+	mu   sync.Mutex
+}
+
+func (p *FilePublisher) Publish(_ context.Context, message Message) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f, err := os.OpenFile(p.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	// 落盘后才算发布成功
+	return f.Sync()
+}
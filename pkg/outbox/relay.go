@@ -0,0 +1,166 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/outboxevent"
+	"github.com/stark-sim/cas/tools"
+)
+
+const (
+	// DefaultRelayInterval 未配置时发布事件的间隔
+	DefaultRelayInterval = time.Second
+	// DefaultMaxAttempts 达到该次数仍发布失败的事件转为死信，只能手动重新发布
+	DefaultMaxAttempts = 8
+	// 每次发布的数量
+	relayBatchSize = 100
+	// 认领后须在该时间内发布完成，超时后其他 relay 会重新发布
+	relayLease = time.Minute
+)
+
+// Relayer 发布未发布的事件
+type Relayer struct {
+	Client      *ent.Client
+	Publisher   Publisher
+	MaxAttempts int
+}
+
+// NewRelayer maxAttempts 不大于 0 时使用默认值
+func NewRelayer(client *ent.Client, publisher Publisher, maxAttempts int) *Relayer {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	return &Relayer{Client: client, Publisher: publisher, MaxAttempts: maxAttempts}
+}
+
+// Start 后台定期发布，ctx 结束时退出
+func (r *Relayer) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultRelayInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			count, err := r.Relay(ctx)
+			if err != nil && ctx.Err() == nil {
+				logrus.Errorf("failed at relaying outbox events, err: %v", err)
+			}
+			// 还有积压时直接发布下一批
+			if err == nil && count == relayBatchSize {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+/*
+Relay 按 ID 顺序认领一批事件并提交，再逐个发布，返回发布成功的数量
+发布成功后才标记为已发布，标记失败时会重复发布
+同一聚合的事件发布失败后，该聚合后续的事件留到下次，保证同一聚合内的顺序
+达到重试次数的事件转为死信，该聚合后续的事件在下次继续发布
+*/
+func (r *Relayer) Relay(ctx context.Context) (int, error) {
+	events, claimedUntil, err := r.claim(ctx)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+	// 认领到期后其他 relay 会重新发布，不再继续
+	publishCtx, cancel := context.WithDeadline(ctx, claimedUntil)
+	defer cancel()
+	count := 0
+	blocked := make(map[string]bool)
+	for _, event := range events {
+		aggregate := aggregateOf(event)
+		update := r.Client.OutboxEvent.UpdateOne(event).SetClaimedUntil(tools.ZeroTime)
+		// 被阻塞的事件只释放认领
+		if !blocked[aggregate] {
+			if err = r.Publisher.Publish(publishCtx, NewMessage(event)); err != nil {
+				blocked[aggregate] = true
+				update.AddAttempts(1).SetLastError(err.Error())
+				if attempts := event.Attempts + 1; attempts >= r.MaxAttempts {
+					logrus.Warnf("outbox event %d of %s dead after %d attempts, err: %v", event.ID, aggregate, attempts, err)
+					update.SetDeadAt(time.Now())
+				} else {
+					logrus.Warnf("failed at publishing outbox event %d of %s, err: %v", event.ID, aggregate, err)
+				}
+			} else {
+				count++
+				update.SetPublishedAt(time.Now())
+			}
+		}
+		// 失败时之后的事件在认领到期后自动释放
+		if err = update.Exec(ctx); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+/*
+claim 在事务中加行锁取出一批未发布的事件，把可以发布的标记为已认领后提交，发布时不再持有锁
+某个聚合的事件被其他 relay 认领时，该聚合后续的事件都不认领，多个进程同时运行时不会乱序
+*/
+func (r *Relayer) claim(ctx context.Context) ([]*ent.OutboxEvent, time.Time, error) {
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	now := time.Now()
+	events, err := tx.OutboxEvent.Query().
+		Where(outboxevent.PublishedAtEQ(tools.ZeroTime), outboxevent.DeadAtEQ(tools.ZeroTime), tools.ForUpdate).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(relayBatchSize).
+		// DISTINCT 不能与行锁一起使用
+		Unique(false).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, time.Time{}, err
+	}
+	claimed := make([]*ent.OutboxEvent, 0, len(events))
+	ids := make([]int64, 0, len(events))
+	blocked := make(map[string]bool)
+	for _, event := range events {
+		aggregate := aggregateOf(event)
+		if blocked[aggregate] || event.ClaimedUntil.After(now) {
+			blocked[aggregate] = true
+			continue
+		}
+		claimed = append(claimed, event)
+		ids = append(ids, event.ID)
+	}
+	claimedUntil := now.Add(relayLease)
+	if len(ids) > 0 {
+		if err = tx.OutboxEvent.Update().Where(outboxevent.IDIn(ids...)).SetClaimedUntil(claimedUntil).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return nil, time.Time{}, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, time.Time{}, err
+	}
+	return claimed, claimedUntil, nil
+}
+
+func aggregateOf(event *ent.OutboxEvent) string {
+	return fmt.Sprintf("%s:%d", event.AggregateType, event.AggregateID)
+}
+
+// Replay 重新发布死信，重置重试次数
+func Replay(ctx context.Context, client *ent.Client, id int64) (*ent.OutboxEvent, error) {
+	return client.OutboxEvent.UpdateOneID(id).
+		SetDeadAt(tools.ZeroTime).
+		SetAttempts(0).
+		SetLastError("").
+		Save(ctx)
+}
@@ -0,0 +1,237 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/enttest"
+	"github.com/stark-sim/cas/tools"
+)
+
+func openClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// newEvent 按创建顺序生成递增的 ID
+func newEvent(t *testing.T, client *ent.Client, aggregateType string, aggregateID int64) *ent.OutboxEvent {
+	t.Helper()
+	return client.OutboxEvent.Create().
+		SetEventType(aggregateType + ".updated").
+		SetAggregateType(aggregateType).
+		SetAggregateID(aggregateID).
+		SetPayload(json.RawMessage(`{}`)).
+		SaveX(context.Background())
+}
+
+// flakyPublisher 对 failing 中的事件返回错误，其余交给 MemoryPublisher
+type flakyPublisher struct {
+	MemoryPublisher
+	mu      sync.Mutex
+	failing map[int64]bool
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, message Message) error {
+	p.mu.Lock()
+	fail := p.failing[message.ID]
+	p.mu.Unlock()
+	if fail {
+		return errors.New("bus unavailable")
+	}
+	return p.MemoryPublisher.Publish(ctx, message)
+}
+
+func (p *flakyPublisher) recover(id int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.failing, id)
+}
+
+func publishedIDs(p *MemoryPublisher) []int64 {
+	var res []int64
+	for _, v := range p.Messages() {
+		res = append(res, v.ID)
+	}
+	return res
+}
+
+func relay(t *testing.T, r *Relayer, want int) {
+	t.Helper()
+	count, err := r.Relay(context.Background())
+	if err != nil {
+		t.Fatalf("relay: %v", err)
+	}
+	if count != want {
+		t.Fatalf("relayed %d events, want %d", count, want)
+	}
+}
+
+func TestRelayOrder(t *testing.T) {
+	client := openClient(t)
+	var want []int64
+	for _, aggregateID := range []int64{1, 2, 1, 3, 2, 1} {
+		want = append(want, newEvent(t, client, ent.TypeUser, aggregateID).ID)
+	}
+	publisher := &MemoryPublisher{}
+	r := NewRelayer(client, publisher, 0)
+	relay(t, r, len(want))
+	if got := publishedIDs(publisher); !reflect.DeepEqual(got, want) {
+		t.Errorf("published %v, want %v", got, want)
+	}
+	// 已发布的不再发布
+	relay(t, r, 0)
+	for _, v := range client.OutboxEvent.Query().AllX(context.Background()) {
+		if v.PublishedAt.Equal(tools.ZeroTime) || !v.ClaimedUntil.Equal(tools.ZeroTime) {
+			t.Errorf("event %d: published_at %v, claimed_until %v", v.ID, v.PublishedAt, v.ClaimedUntil)
+		}
+	}
+}
+
+func TestRelayRetry(t *testing.T) {
+	ctx := context.Background()
+	client := openClient(t)
+	first := newEvent(t, client, ent.TypeUser, 1)
+	publisher := &flakyPublisher{failing: map[int64]bool{first.ID: true}}
+	r := NewRelayer(client, publisher, 0)
+	relay(t, r, 0)
+	relay(t, r, 0)
+	event := client.OutboxEvent.GetX(ctx, first.ID)
+	if event.Attempts != 2 || event.LastError != "bus unavailable" {
+		t.Errorf("attempts %d, last_error %q", event.Attempts, event.LastError)
+	}
+	// 失败后释放认领，下次重试
+	if !event.ClaimedUntil.Equal(tools.ZeroTime) || !event.PublishedAt.Equal(tools.ZeroTime) || !event.DeadAt.Equal(tools.ZeroTime) {
+		t.Errorf("claimed_until %v, published_at %v, dead_at %v", event.ClaimedUntil, event.PublishedAt, event.DeadAt)
+	}
+	publisher.recover(first.ID)
+	relay(t, r, 1)
+	if got := publishedIDs(&publisher.MemoryPublisher); !reflect.DeepEqual(got, []int64{first.ID}) {
+		t.Errorf("published %v", got)
+	}
+}
+
+func TestRelayBlocksAggregate(t *testing.T) {
+	client := openClient(t)
+	a1 := newEvent(t, client, ent.TypeUser, 1)
+	b1 := newEvent(t, client, ent.TypeUser, 2)
+	a2 := newEvent(t, client, ent.TypeUser, 1)
+	// 聚合类型不同时互不影响
+	c1 := newEvent(t, client, ent.TypeRole, 1)
+	publisher := &flakyPublisher{failing: map[int64]bool{a1.ID: true}}
+	r := NewRelayer(client, publisher, 0)
+	relay(t, r, 2)
+	if got := publishedIDs(&publisher.MemoryPublisher); !reflect.DeepEqual(got, []int64{b1.ID, c1.ID}) {
+		t.Fatalf("published %v", got)
+	}
+	if event := client.OutboxEvent.GetX(context.Background(), a2.ID); event.Attempts != 0 || !event.ClaimedUntil.Equal(tools.ZeroTime) {
+		t.Errorf("blocked event: attempts %d, claimed_until %v", event.Attempts, event.ClaimedUntil)
+	}
+	publisher.recover(a1.ID)
+	relay(t, r, 2)
+	if got := publishedIDs(&publisher.MemoryPublisher); !reflect.DeepEqual(got, []int64{b1.ID, c1.ID, a1.ID, a2.ID}) {
+		t.Errorf("published %v", got)
+	}
+}
+
+func TestRelaySkipsClaimedAggregate(t *testing.T) {
+	ctx := context.Background()
+	client := openClient(t)
+	a1 := newEvent(t, client, ent.TypeUser, 1)
+	a2 := newEvent(t, client, ent.TypeUser, 1)
+	b1 := newEvent(t, client, ent.TypeUser, 2)
+	// 其他 relay 正在发布 a1
+	client.OutboxEvent.UpdateOne(a1).SetClaimedUntil(time.Now().Add(time.Minute)).ExecX(ctx)
+	publisher := &MemoryPublisher{}
+	r := NewRelayer(client, publisher, 0)
+	relay(t, r, 1)
+	if got := publishedIDs(publisher); !reflect.DeepEqual(got, []int64{b1.ID}) {
+		t.Fatalf("published %v", got)
+	}
+	// 认领到期后重新发布
+	client.OutboxEvent.UpdateOne(a1).SetClaimedUntil(time.Now().Add(-time.Second)).ExecX(ctx)
+	relay(t, r, 2)
+	if got := publishedIDs(publisher); !reflect.DeepEqual(got, []int64{b1.ID, a1.ID, a2.ID}) {
+		t.Errorf("published %v", got)
+	}
+}
+
+func TestRelayDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	client := openClient(t)
+	a1 := newEvent(t, client, ent.TypeUser, 1)
+	a2 := newEvent(t, client, ent.TypeUser, 1)
+	publisher := &flakyPublisher{failing: map[int64]bool{a1.ID: true}}
+	r := NewRelayer(client, publisher, 2)
+	relay(t, r, 0)
+	if event := client.OutboxEvent.GetX(ctx, a1.ID); !event.DeadAt.Equal(tools.ZeroTime) {
+		t.Fatalf("dead after %d attempts", event.Attempts)
+	}
+	relay(t, r, 0)
+	event := client.OutboxEvent.GetX(ctx, a1.ID)
+	if event.DeadAt.Equal(tools.ZeroTime) || event.Attempts != 2 {
+		t.Fatalf("dead_at %v, attempts %d", event.DeadAt, event.Attempts)
+	}
+	// 死信不再阻塞该聚合后续的事件
+	relay(t, r, 1)
+	relay(t, r, 0)
+	if got := publishedIDs(&publisher.MemoryPublisher); !reflect.DeepEqual(got, []int64{a2.ID}) {
+		t.Fatalf("published %v", got)
+	}
+	publisher.recover(a1.ID)
+	replayed, err := Replay(ctx, client, a1.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !replayed.DeadAt.Equal(tools.ZeroTime) || replayed.Attempts != 0 || replayed.LastError != "" {
+		t.Errorf("replayed: dead_at %v, attempts %d, last_error %q", replayed.DeadAt, replayed.Attempts, replayed.LastError)
+	}
+	relay(t, r, 1)
+	if got := publishedIDs(&publisher.MemoryPublisher); !reflect.DeepEqual(got, []int64{a2.ID, a1.ID}) {
+		t.Errorf("published %v", got)
+	}
+}
+
+func TestRelayFilePublisher(t *testing.T) {
+	client := openClient(t)
+	var want []int64
+	for _, aggregateID := range []int64{1614978329427251200, 2, 1614978329427251200} {
+		want = append(want, newEvent(t, client, ent.TypeUser, aggregateID).ID)
+	}
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	r := NewRelayer(client, &FilePublisher{Path: path}, 0)
+	relay(t, r, len(want))
+	relay(t, r, 0)
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var got []int64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var message Message
+		if err = json.Unmarshal(scanner.Bytes(), &message); err != nil {
+			t.Fatalf("line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, message.ID)
+		// snowflake ID 以字符串写入，不丢失精度
+		if message.AggregateID != 1614978329427251200 && message.AggregateID != 2 {
+			t.Errorf("aggregate_id %d", message.AggregateID)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("written %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// 超过该时长没有请求的客户端不再保留
+	idleTimeout   = 3 * time.Minute
+	sweepInterval = time.Minute
+)
+
+/*
+Limiter 按 key（通常为客户端 IP）的令牌桶限流，每秒请求数为 0 时不限流
+限额可在运行中通过 Update 修改，已有的客户端立即使用新的限额
+*/
+type Limiter struct {
+	mu        sync.Mutex
+	limit     rate.Limit
+	burst     int
+	clients   map[string]*client
+	lastSweep time.Time
+}
+
+type client struct {
+	limiter *rate.Limiter
+	seen    time.Time
+}
+
+func NewLimiter(requestsPerSecond float64, burst int) *Limiter {
+	l := &Limiter{clients: make(map[string]*client), lastSweep: time.Now()}
+	l.Update(requestsPerSecond, burst)
+	return l
+}
+
+// Update 修改限额，burst 为 0 时与每秒请求数相同
+func (l *Limiter) Update(requestsPerSecond float64, burst int) {
+	if burst <= 0 {
+		burst = int(requestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limit, l.burst = rate.Limit(requestsPerSecond), burst
+	for _, c := range l.clients {
+		c.limiter.SetLimit(l.limit)
+		c.limiter.SetBurst(l.burst)
+	}
+}
+
+// Allow key 此时是否可以再发起一个请求
+func (l *Limiter) Allow(key string) bool {
+	now := time.Now()
+	l.mu.Lock()
+	if l.limit <= 0 {
+		l.mu.Unlock()
+		return true
+	}
+	if now.Sub(l.lastSweep) > sweepInterval {
+		l.sweep(now)
+	}
+	c, ok := l.clients[key]
+	if !ok {
+		c = &client{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[key] = c
+	}
+	c.seen = now
+	l.mu.Unlock()
+	return c.limiter.AllowN(now, 1)
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	for key, c := range l.clients {
+		if now.Sub(c.seen) > idleTimeout {
+			delete(l.clients, key)
+		}
+	}
+	l.lastSweep = now
+}
@@ -0,0 +1,70 @@
+package rbac
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/role"
+	"github.com/stark-sim/cas/pkg/ent/user"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/tools"
+)
+
+// Orphan 指向不存在或已删除的用户、角色的有效授权
+type Orphan struct {
+	UserRoleID  int64
+	UserID      int64
+	RoleID      int64
+	MissingUser bool
+	MissingRole bool
+}
+
+/*
+CheckAssignments 找出所有孤立的授权，repair 时以系统身份软删除它们
+数据库没有外键约束，历史数据或绕过 hook 的写入都可能留下这样的授权
+*/
+func CheckAssignments(ctx context.Context, client *ent.Client, repair bool) ([]Orphan, error) {
+	missingUser, err := client.UserRole.Query().
+		Where(userrole.Not(userrole.HasUserWith(user.DeletedAtEQ(tools.ZeroTime)))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	missingRole, err := client.UserRole.Query().
+		Where(userrole.Not(userrole.HasRoleWith(role.DeletedAtEQ(tools.ZeroTime)))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orphans := make([]Orphan, 0, len(missingUser)+len(missingRole))
+	index := make(map[int64]int)
+	for _, v := range missingUser {
+		index[v.ID] = len(orphans)
+		orphans = append(orphans, Orphan{UserRoleID: v.ID, UserID: v.UserID, RoleID: v.RoleID, MissingUser: true})
+	}
+	for _, v := range missingRole {
+		if i, ok := index[v.ID]; ok {
+			orphans[i].MissingRole = true
+			continue
+		}
+		orphans = append(orphans, Orphan{UserRoleID: v.ID, UserID: v.UserID, RoleID: v.RoleID, MissingRole: true})
+	}
+	if !repair || len(orphans) == 0 {
+		return orphans, nil
+	}
+	ids := make([]int64, 0, len(orphans))
+	for _, v := range orphans {
+		ids = append(ids, v.UserRoleID)
+	}
+	affected, err := client.UserRole.Update().
+		Where(userrole.IDIn(ids...), userrole.DeletedAtEQ(tools.ZeroTime)).
+		SetDeletedAt(time.Now()).
+		Save(tools.WithSystemPrincipal(ctx))
+	if err != nil {
+		return orphans, err
+	}
+	logrus.Printf("repaired %d orphaned role assignments", affected)
+	return orphans, nil
+}
@@ -0,0 +1,157 @@
+package rbac
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/role"
+	"github.com/stark-sim/cas/pkg/ent/schema"
+	"github.com/stark-sim/cas/pkg/ent/user"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/tools"
+)
+
+// PurgeUser 物理删除用户及其全部授权（包括已删除的），不可恢复
+func PurgeUser(ctx context.Context, client *ent.Client, userID int64) error {
+	return withTx(schema.SkipSoftDelete(ctx), client, func(ctx context.Context, tx *ent.Tx) error {
+		if _, err := tx.UserRole.Delete().Where(userrole.UserID(userID)).Exec(ctx); err != nil {
+			return err
+		}
+		return tx.User.DeleteOneID(userID).Exec(ctx)
+	})
+}
+
+// PurgeRole 物理删除角色及其全部授权（包括已删除的），不可恢复
+func PurgeRole(ctx context.Context, client *ent.Client, roleID int64) error {
+	return withTx(schema.SkipSoftDelete(ctx), client, func(ctx context.Context, tx *ent.Tx) error {
+		if _, err := tx.UserRole.Delete().Where(userrole.RoleID(roleID)).Exec(ctx); err != nil {
+			return err
+		}
+		return tx.Role.DeleteOneID(roleID).Exec(ctx)
+	})
+}
+
+// DeleteUser 软删除用户，其授权在同一事务中一并软删除
+func DeleteUser(ctx context.Context, client *ent.Client, userID int64) (*ent.User, error) {
+	var res *ent.User
+	err := withTx(ctx, client, func(ctx context.Context, tx *ent.Tx) error {
+		// 已删除的查不到，避免覆盖原删除时间
+		_user, err := tx.User.Get(ctx, userID)
+		if err != nil {
+			return err
+		}
+		res, err = _user.Update().SetDeletedAt(time.Now()).Save(ctx)
+		return err
+	})
+	return res, err
+}
+
+// DeleteRole 软删除角色，其授权在同一事务中一并软删除
+func DeleteRole(ctx context.Context, client *ent.Client, roleID int64) (*ent.Role, error) {
+	var res *ent.Role
+	err := withTx(ctx, client, func(ctx context.Context, tx *ent.Tx) error {
+		// 已删除的查不到，避免覆盖原删除时间
+		_role, err := tx.Role.Get(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		res, err = _role.Update().SetDeletedAt(time.Now()).Save(ctx)
+		return err
+	})
+	return res, err
+}
+
+/*
+RestoreUser 恢复已删除的用户，withAssignments 时一并恢复随用户删除的授权
+单独撤销的授权以及角色已删除的授权不会恢复
+*/
+func RestoreUser(ctx context.Context, client *ent.Client, userID int64, withAssignments bool) (*ent.User, error) {
+	var res *ent.User
+	err := withTx(schema.SkipSoftDelete(ctx), client, func(ctx context.Context, tx *ent.Tx) error {
+		_user, err := tx.User.Get(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if res, err = _user.Update().SetDeletedAt(tools.ZeroTime).Save(ctx); err != nil {
+			return err
+		}
+		if !withAssignments || _user.DeletedAt.IsZero() {
+			return nil
+		}
+		_, err = tx.UserRole.Update().
+			Where(userrole.UserID(userID), userrole.DeletedAtEQ(_user.DeletedAt), userrole.HasRoleWith(role.DeletedAtEQ(tools.ZeroTime))).
+			SetDeletedAt(tools.ZeroTime).
+			Save(ctx)
+		return err
+	})
+	return res, err
+}
+
+/*
+RestoreRole 恢复已删除的角色，withAssignments 时一并恢复随角色删除的授权
+单独撤销的授权以及用户已删除的授权不会恢复
+*/
+func RestoreRole(ctx context.Context, client *ent.Client, roleID int64, withAssignments bool) (*ent.Role, error) {
+	var res *ent.Role
+	err := withTx(schema.SkipSoftDelete(ctx), client, func(ctx context.Context, tx *ent.Tx) error {
+		_role, err := tx.Role.Get(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		if res, err = _role.Update().SetDeletedAt(tools.ZeroTime).Save(ctx); err != nil {
+			return err
+		}
+		if !withAssignments || _role.DeletedAt.IsZero() {
+			return nil
+		}
+		_, err = tx.UserRole.Update().
+			Where(userrole.RoleID(roleID), userrole.DeletedAtEQ(_role.DeletedAt), userrole.HasUserWith(user.DeletedAtEQ(tools.ZeroTime))).
+			SetDeletedAt(tools.ZeroTime).
+			Save(ctx)
+		return err
+	})
+	return res, err
+}
+
+/*
+AfterCommit ctx 中的事务提交成功后执行 fn，没有事务时立即执行
+指标等只应在变更生效后记录，回滚时不执行
+*/
+func AfterCommit(ctx context.Context, fn func()) {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		onCommit(tx, fn)
+		return
+	}
+	fn()
+}
+
+func onCommit(tx *ent.Tx, fn func()) {
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			fn()
+			return nil
+		})
+	})
+}
+
+// withTx ctx 中已有事务（entgql.Transactioner 或 gRPC 拦截器开启）时加入该事务，由开启方提交
+func withTx(ctx context.Context, client *ent.Client, fn func(ctx context.Context, tx *ent.Tx) error) error {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return fn(ctx, tx)
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err = fn(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		logrus.WithContext(ctx).Errorf("failed in transaction, err: %v", err)
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,108 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/predicate"
+	"github.com/stark-sim/cas/pkg/ent/role"
+	"github.com/stark-sim/cas/pkg/ent/schema"
+	"github.com/stark-sim/cas/pkg/ent/user"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/pkg/metrics"
+	"github.com/stark-sim/cas/tools"
+)
+
+var (
+	ErrInvalidWindow = errors.New("valid_until must be after valid_from")
+	ErrUnknownGrant  = errors.New("user or role does not exist")
+)
+
+/*
+ActiveGrant 在 now 时刻生效的授权：未删除，且处于 [valid_from, valid_until) 内，零值表示不限
+所有角色解析都应该经过这里
+*/
+func ActiveGrant(now time.Time) predicate.UserRole {
+	return userrole.And(
+		userrole.DeletedAtEQ(tools.ZeroTime),
+		userrole.ValidFromLTE(now),
+		userrole.Or(userrole.ValidUntilEQ(tools.ZeroTime), userrole.ValidUntilGT(now)),
+	)
+}
+
+// UserRoles 用户当前生效的角色
+func UserRoles(ctx context.Context, client *ent.Client, userID int64) ([]*ent.Role, error) {
+	return UserRolesQuery(client, userID).All(ctx)
+}
+
+// UserRolesQuery 用户当前生效的角色，供分页等进一步处理
+func UserRolesQuery(client *ent.Client, userID int64) *ent.RoleQuery {
+	return client.Role.Query().Where(role.HasUserRolesWith(userrole.UserID(userID), ActiveGrant(time.Now())))
+}
+
+// RoleUsersQuery 当前拥有该角色的用户，供分页等进一步处理
+func RoleUsersQuery(client *ent.Client, roleID int64) *ent.UserQuery {
+	return client.User.Query().Where(user.HasUserRolesWith(userrole.RoleID(roleID), ActiveGrant(time.Now())))
+}
+
+/*
+Grant 授予角色并设置有效期，已存在的授权（包括已删除的）会被恢复并更新有效期
+user_id, role_id 上有唯一索引，所以不能重复插入；事务中先锁住用户行，同一用户的授权依次执行，
+后执行的能查到先提交的授权，并发授权不会在唯一索引上冲突
+*/
+func Grant(ctx context.Context, client *ent.Client, userID, roleID int64, validFrom, validUntil time.Time) (*ent.UserRole, error) {
+	if !validFrom.IsZero() && !validUntil.IsZero() && !validUntil.After(validFrom) {
+		return nil, ErrInvalidWindow
+	}
+	var res *ent.UserRole
+	err := withTx(ctx, client, func(ctx context.Context, tx *ent.Tx) error {
+		// 不给已删除或不存在的用户、角色授权
+		userExists, err := tx.User.Query().Where(user.ID(userID), tools.ForUpdate).Unique(false).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		roleExists, err := tx.Role.Query().Where(role.ID(roleID)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !userExists || !roleExists {
+			return ErrUnknownGrant
+		}
+		// 已删除的授权也要查出来
+		existing, err := tx.UserRole.Query().Where(userrole.UserID(userID), userrole.RoleID(roleID)).Only(schema.SkipSoftDelete(ctx))
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if existing != nil {
+			res, err = tx.UserRole.UpdateOne(existing).
+				SetDeletedAt(tools.ZeroTime).
+				SetValidFrom(validFrom).
+				SetValidUntil(validUntil).
+				Save(ctx)
+		} else {
+			res, err = tx.UserRole.Create().
+				SetUserID(userID).
+				SetRoleID(roleID).
+				SetValidFrom(validFrom).
+				SetValidUntil(validUntil).
+				Save(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		onCommit(tx, metrics.RoleGrant)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Revoke 撤销授权，未授权时同样成功
+func Revoke(ctx context.Context, client *ent.Client, userID, roleID int64) error {
+	_, err := client.UserRole.Delete().Where(userrole.UserID(userID), userrole.RoleID(roleID)).Exec(ctx)
+	return err
+}
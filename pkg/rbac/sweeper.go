@@ -0,0 +1,76 @@
+package rbac
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/tools"
+)
+
+// DefaultSweepInterval 未配置时清理过期授权的间隔
+const DefaultSweepInterval = time.Minute
+
+/*
+StartSweeper 后台定期软删除已过期的授权，ctx 结束时退出
+多个进程同时运行也是安全的，只会处理 deleted_at 仍为零值的授权
+*/
+func StartSweeper(ctx context.Context, client *ent.Client, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSweepInterval
+	}
+	// 后台任务以系统身份写入
+	ctx = tools.WithSystemPrincipal(ctx)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if _, err := SweepExpired(ctx, client); err != nil && ctx.Err() == nil {
+				logrus.Errorf("failed at sweeping expired role grants, err: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// SweepExpired 软删除所有已过期的授权，返回处理数量
+func SweepExpired(ctx context.Context, client *ent.Client) (int, error) {
+	now := time.Now()
+	expired, err := client.UserRole.Query().Where(
+		userrole.DeletedAtEQ(tools.ZeroTime),
+		userrole.ValidUntilNEQ(tools.ZeroTime),
+		userrole.ValidUntilLTE(now),
+	).All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, v := range expired {
+		// 逐条更新，保证每条授权都经过 mutation hook，已被其他进程处理的跳过
+		affected, err := client.UserRole.Update().
+			Where(userrole.ID(v.ID), userrole.DeletedAtEQ(tools.ZeroTime)).
+			SetDeletedAt(now).
+			Save(ctx)
+		if err != nil {
+			return count, err
+		}
+		if affected == 0 {
+			continue
+		}
+		count++
+		logrus.WithFields(logrus.Fields{
+			"audit":       "role_grant_expired",
+			"user_role":   v.ID,
+			"user_id":     v.UserID,
+			"role_id":     v.RoleID,
+			"valid_until": v.ValidUntil,
+		}).Infof("expired role grant %d swept", v.ID)
+	}
+	return count, nil
+}
@@ -0,0 +1,625 @@
+package rebac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/auditevent"
+	"github.com/stark-sim/cas/pkg/ent/hook"
+	"github.com/stark-sim/cas/pkg/ent/relationtuple"
+	"github.com/stark-sim/cas/pkg/ent/role"
+	"github.com/stark-sim/cas/pkg/ent/userrole"
+	"github.com/stark-sim/cas/pkg/rbac"
+	"github.com/stark-sim/cas/tools"
+)
+
+const (
+	// 防止 rewrite 或 userset 成环
+	maxDepth = 25
+	// 不带令牌的 Check 可以接受的缓存时长
+	cacheTTL        = 5 * time.Second
+	maxCacheEntries = 10000
+)
+
+var (
+	ErrMaxDepth        = errors.New("relation check exceeded max depth")
+	ErrInvalidTuple    = errors.New("invalid relation tuple")
+	ErrUnknownRelation = errors.New("unknown relation")
+	ErrInvalidToken    = errors.New("invalid consistency token")
+)
+
+type cached struct {
+	allowed  bool
+	revision int64
+	at       time.Time
+}
+
+/*
+Engine 关系元组的 Check / Expand / Write / ListObjects 实现
+
+一致性令牌为数据库的版本号，即已提交的最大审计日志 ID：Write 提交后读取，Check 结果记录计算开始时的版本号。
+审计日志 ID 与提交顺序不一致，晚提交的写入 ID 可能更小，写入后的令牌可能与写入前相同，
+所以只有版本号大于令牌的缓存结果才一定包含了该写入，相等时重新读库；令牌比数据库还新时拒绝请求
+*/
+type Engine struct {
+	client     *ent.Client
+	namespaces namespaces
+
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+func NewEngine(client *ent.Client, configs ...Namespace) (*Engine, error) {
+	ns, err := buildNamespaces(append(DefaultNamespaces(), configs...))
+	if err != nil {
+		return nil, err
+	}
+	e := &Engine{client: client, namespaces: ns, cache: make(map[string]cached)}
+	client.Use(hook.If(e.invalidateHook, hook.Condition(func(_ context.Context, m ent.Mutation) bool {
+		return tools.IsOneOf(m.Type(), ent.TypeRelationTuple, ent.TypeUserRole, ent.TypeRole)
+	})))
+	return e, nil
+}
+
+func encodeToken(revision int64) string {
+	return strconv.FormatInt(revision, 10)
+}
+
+func decodeToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	revision, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || revision < 0 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidToken, token)
+	}
+	return revision, nil
+}
+
+// revision 数据库当前的版本号，元组与授权的每次变更都会写入审计日志
+func (e *Engine) revision(ctx context.Context) (int64, error) {
+	id, err := e.client.AuditEvent.Query().Order(ent.Desc(auditevent.FieldID)).FirstID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("failed at querying relation revision, err: %v", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// snapshot 读库前取得当前版本号，令牌不能比数据库新
+func (e *Engine) snapshot(ctx context.Context, minRevision int64) (int64, error) {
+	revision, err := e.revision(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if minRevision > revision {
+		return 0, fmt.Errorf("%w: %d is ahead of the database", ErrInvalidToken, minRevision)
+	}
+	return revision, nil
+}
+
+// Check 判断 tuple 是否成立，返回结果对应的一致性令牌
+func (e *Engine) Check(ctx context.Context, tuple Tuple, token string) (bool, string, error) {
+	if err := tuple.validate(); err != nil {
+		return false, "", err
+	}
+	minRevision, err := decodeToken(token)
+	if err != nil {
+		return false, "", err
+	}
+	key := tuple.String()
+	e.mu.Lock()
+	entry, ok := e.cache[key]
+	e.mu.Unlock()
+	if ok && (minRevision == 0 || entry.revision > minRevision) && time.Since(entry.at) < cacheTTL {
+		return entry.allowed, encodeToken(entry.revision), nil
+	}
+	revision, err := e.snapshot(ctx, minRevision)
+	if err != nil {
+		return false, "", err
+	}
+	allowed, err := e.check(ctx, tuple.Namespace, tuple.ObjectID, tuple.Relation, tuple.Subject, 0)
+	if err != nil {
+		return false, "", err
+	}
+	e.mu.Lock()
+	if len(e.cache) >= maxCacheEntries {
+		e.cache = make(map[string]cached)
+	}
+	e.cache[key] = cached{allowed: allowed, revision: revision, at: time.Now()}
+	e.mu.Unlock()
+	return allowed, encodeToken(revision), nil
+}
+
+func (e *Engine) check(ctx context.Context, namespace, objectID, relation string, subject Subject, depth int) (bool, error) {
+	if depth > maxDepth {
+		return false, ErrMaxDepth
+	}
+	union, err := e.namespaces.rewrite(namespace, relation)
+	if err != nil {
+		return false, err
+	}
+	for _, u := range union {
+		var ok bool
+		switch {
+		case u.This:
+			ok, err = e.checkDirect(ctx, namespace, objectID, relation, subject, depth)
+		case u.ComputedUserset != "":
+			ok, err = e.check(ctx, namespace, objectID, u.ComputedUserset, subject, depth+1)
+		case u.TupleToUserset != nil:
+			ok, err = e.checkTupleToUserset(ctx, namespace, objectID, u.TupleToUserset, subject, depth)
+		}
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (e *Engine) checkDirect(ctx context.Context, namespace, objectID, relation string, subject Subject, depth int) (bool, error) {
+	subjects, err := e.directSubjects(ctx, namespace, objectID, relation)
+	if err != nil {
+		return false, err
+	}
+	for _, s := range subjects {
+		if s == subject {
+			return true, nil
+		}
+	}
+	// 再展开 userset 类型的 subject
+	for _, s := range subjects {
+		if s.Relation == "" {
+			continue
+		}
+		ok, err := e.check(ctx, s.Namespace, s.ID, s.Relation, subject, depth+1)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (e *Engine) checkTupleToUserset(ctx context.Context, namespace, objectID string, ttu *TupleToUserset, subject Subject, depth int) (bool, error) {
+	objects, err := e.directSubjects(ctx, namespace, objectID, ttu.Tupleset)
+	if err != nil {
+		return false, err
+	}
+	for _, o := range objects {
+		ok, err := e.check(ctx, o.Namespace, o.ID, ttu.ComputedUserset, subject, depth+1)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// directSubjects 直接写在 object#relation 上的 subject，role#member 同时包含 user_roles 中的授权
+func (e *Engine) directSubjects(ctx context.Context, namespace, objectID, relation string) ([]Subject, error) {
+	tuples, err := e.client.RelationTuple.Query().Where(
+		relationtuple.Namespace(namespace),
+		relationtuple.ObjectID(objectID),
+		relationtuple.Relation(relation),
+	).All(ctx)
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("failed at querying relation tuples, err: %v", err)
+		return nil, err
+	}
+	subjects := make([]Subject, 0, len(tuples))
+	for _, t := range tuples {
+		subjects = append(subjects, Subject{Namespace: t.SubjectNamespace, ID: t.SubjectID, Relation: t.SubjectRelation})
+	}
+	if namespace == RoleNamespace && relation == MemberRelation {
+		userRoles, err := e.client.UserRole.Query().
+			Where(userrole.RoleID(tools.StringToInt64(objectID)), rbac.ActiveGrant(time.Now())).
+			All(ctx)
+		if err != nil {
+			logrus.WithContext(ctx).Errorf("failed at querying user roles, err: %v", err)
+			return nil, err
+		}
+		for _, v := range userRoles {
+			subjects = append(subjects, Subject{Namespace: UserNamespace, ID: strconv.FormatInt(v.UserID, 10)})
+		}
+	}
+	return subjects, nil
+}
+
+// Expand 展开 object#relation 的 userset 树，userset 类型的 subject 留给调用方继续展开
+func (e *Engine) Expand(ctx context.Context, namespace, objectID, relation, token string) (*Tree, string, error) {
+	minRevision, err := decodeToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+	revision, err := e.snapshot(ctx, minRevision)
+	if err != nil {
+		return nil, "", err
+	}
+	tree, err := e.expand(ctx, namespace, objectID, relation, 0)
+	if err != nil {
+		return nil, "", err
+	}
+	return tree, encodeToken(revision), nil
+}
+
+func (e *Engine) expand(ctx context.Context, namespace, objectID, relation string, depth int) (*Tree, error) {
+	if depth > maxDepth {
+		return nil, ErrMaxDepth
+	}
+	union, err := e.namespaces.rewrite(namespace, relation)
+	if err != nil {
+		return nil, err
+	}
+	node := &Tree{Operation: "union", Namespace: namespace, ObjectID: objectID, Relation: relation}
+	for _, u := range union {
+		switch {
+		case u.This:
+			subjects, err := e.directSubjects(ctx, namespace, objectID, relation)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, &Tree{Operation: "leaf", Namespace: namespace, ObjectID: objectID, Relation: relation, Subjects: subjects})
+		case u.ComputedUserset != "":
+			child, err := e.expand(ctx, namespace, objectID, u.ComputedUserset, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, child)
+		case u.TupleToUserset != nil:
+			objects, err := e.directSubjects(ctx, namespace, objectID, u.TupleToUserset.Tupleset)
+			if err != nil {
+				return nil, err
+			}
+			for _, o := range objects {
+				child, err := e.expand(ctx, o.Namespace, o.ID, u.TupleToUserset.ComputedUserset, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				node.Children = append(node.Children, child)
+			}
+		}
+	}
+	return node, nil
+}
+
+/*
+ListObjects 列出 subject 在 namespace 中拥有 relation 的对象
+从 subject 出发反向查询元组：this 直接按 subject 查，userset 与 tuple_to_userset 先求出关联对象再查，
+rewrite 成环时重复计算直到结果不再增加
+*/
+func (e *Engine) ListObjects(ctx context.Context, namespace, relation string, subject Subject, token string) ([]string, string, error) {
+	if _, err := e.namespaces.rewrite(namespace, relation); err != nil {
+		return nil, "", err
+	}
+	if subject.Namespace == "" || subject.ID == "" {
+		return nil, "", fmt.Errorf("%w: subject is required", ErrInvalidTuple)
+	}
+	minRevision, err := decodeToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+	revision, err := e.snapshot(ctx, minRevision)
+	if err != nil {
+		return nil, "", err
+	}
+	l := &lister{engine: e, subject: subject, results: make(map[string]map[string]bool), active: make(map[string]bool)}
+	var set map[string]bool
+	for {
+		l.changed = false
+		if set, err = l.objects(ctx, namespace, relation, 0); err != nil {
+			return nil, "", err
+		}
+		if !l.changed {
+			break
+		}
+	}
+	objects := make([]string, 0, len(set))
+	for objectID := range set {
+		objects = append(objects, objectID)
+	}
+	sort.Strings(objects)
+	return objects, encodeToken(revision), nil
+}
+
+// lister 一次 ListObjects 的状态，results 为各 namespace#relation 已求出的对象
+type lister struct {
+	engine  *Engine
+	subject Subject
+	results map[string]map[string]bool
+	// 正在计算的 namespace#relation，再次遇到时先使用已有结果
+	active  map[string]bool
+	changed bool
+}
+
+func (l *lister) objects(ctx context.Context, namespace, relation string, depth int) (map[string]bool, error) {
+	key := namespace + "#" + relation
+	set, ok := l.results[key]
+	if !ok {
+		set = make(map[string]bool)
+		l.results[key] = set
+	}
+	if l.active[key] {
+		return set, nil
+	}
+	if depth > maxDepth {
+		return nil, ErrMaxDepth
+	}
+	union, err := l.engine.namespaces.rewrite(namespace, relation)
+	if err != nil {
+		return nil, err
+	}
+	l.active[key] = true
+	defer delete(l.active, key)
+	add := func(objectIDs []string) {
+		for _, v := range objectIDs {
+			if !set[v] {
+				set[v] = true
+				l.changed = true
+			}
+		}
+	}
+	for _, u := range union {
+		switch {
+		case u.This:
+			objectIDs, err := l.engine.objectsOf(ctx, namespace, relation, l.subject.Namespace, l.subject.Relation, []string{l.subject.ID})
+			if err != nil {
+				return nil, err
+			}
+			add(objectIDs)
+			// subject 属于某个 userset 时，写给该 userset 的元组同样成立
+			usersets, err := l.engine.subjectUsersets(ctx, namespace, relation)
+			if err != nil {
+				return nil, err
+			}
+			for _, us := range usersets {
+				members, err := l.objects(ctx, us.SubjectNamespace, us.SubjectRelation, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				if objectIDs, err = l.engine.objectsOf(ctx, namespace, relation, us.SubjectNamespace, us.SubjectRelation, keys(members)); err != nil {
+					return nil, err
+				}
+				add(objectIDs)
+			}
+		case u.ComputedUserset != "":
+			computed, err := l.objects(ctx, namespace, u.ComputedUserset, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			add(keys(computed))
+		case u.TupleToUserset != nil:
+			parentNamespaces, err := l.engine.client.RelationTuple.Query().
+				Where(relationtuple.Namespace(namespace), relationtuple.Relation(u.TupleToUserset.Tupleset)).
+				Unique(true).
+				Select(relationtuple.FieldSubjectNamespace).
+				Strings(ctx)
+			if err != nil {
+				logrus.WithContext(ctx).Errorf("failed at querying relation tuplesets, err: %v", err)
+				return nil, err
+			}
+			for _, parentNamespace := range parentNamespaces {
+				parents, err := l.objects(ctx, parentNamespace, u.TupleToUserset.ComputedUserset, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				objectIDs, err := l.engine.objectsOf(ctx, namespace, u.TupleToUserset.Tupleset, parentNamespace, "", keys(parents))
+				if err != nil {
+					return nil, err
+				}
+				add(objectIDs)
+			}
+		}
+	}
+	return set, nil
+}
+
+// subjectUsersets 写在 namespace#relation 上的 userset 类型 subject 的种类
+type subjectUserset struct {
+	SubjectNamespace string `json:"subject_namespace"`
+	SubjectRelation  string `json:"subject_relation"`
+}
+
+func (e *Engine) subjectUsersets(ctx context.Context, namespace, relation string) ([]subjectUserset, error) {
+	var res []subjectUserset
+	err := e.client.RelationTuple.Query().
+		Where(relationtuple.Namespace(namespace), relationtuple.Relation(relation), relationtuple.SubjectRelationNEQ("")).
+		Unique(true).
+		Select(relationtuple.FieldSubjectNamespace, relationtuple.FieldSubjectRelation).
+		Scan(ctx, &res)
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("failed at querying relation usersets, err: %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+/*
+objectsOf namespace#relation 上 subject 为 subjectNamespace:<subjectIDs>#subjectRelation 的对象
+role#member 同时包含 user_roles 中的授权，与 directSubjects 一致
+*/
+func (e *Engine) objectsOf(ctx context.Context, namespace, relation, subjectNamespace, subjectRelation string, subjectIDs []string) ([]string, error) {
+	if len(subjectIDs) == 0 {
+		return nil, nil
+	}
+	objectIDs, err := e.client.RelationTuple.Query().
+		Where(
+			relationtuple.Namespace(namespace),
+			relationtuple.Relation(relation),
+			relationtuple.SubjectNamespace(subjectNamespace),
+			relationtuple.SubjectRelation(subjectRelation),
+			relationtuple.SubjectIDIn(subjectIDs...),
+		).
+		Unique(true).
+		Select(relationtuple.FieldObjectID).
+		Strings(ctx)
+	if err != nil {
+		logrus.WithContext(ctx).Errorf("failed at querying relation objects, err: %v", err)
+		return nil, err
+	}
+	if namespace == RoleNamespace && relation == MemberRelation && subjectNamespace == UserNamespace && subjectRelation == "" {
+		userIDs := make([]int64, 0, len(subjectIDs))
+		for _, v := range subjectIDs {
+			userIDs = append(userIDs, tools.StringToInt64(v))
+		}
+		roleIDs, err := e.client.Role.Query().
+			Where(role.HasUserRolesWith(userrole.UserIDIn(userIDs...), rbac.ActiveGrant(time.Now()))).
+			IDs(ctx)
+		if err != nil {
+			logrus.WithContext(ctx).Errorf("failed at querying user roles, err: %v", err)
+			return nil, err
+		}
+		for _, v := range roleIDs {
+			objectIDs = append(objectIDs, strconv.FormatInt(v, 10))
+		}
+	}
+	return objectIDs, nil
+}
+
+func keys(set map[string]bool) []string {
+	res := make([]string, 0, len(set))
+	for k := range set {
+		res = append(res, k)
+	}
+	return res
+}
+
+/*
+Write 在一个事务中写入或删除元组，返回可用于读自己写入的一致性令牌
+role:<role_id>#member@user:<user_id> 直接落在 user_roles 上
+*/
+func (e *Engine) Write(ctx context.Context, updates []Update) (string, error) {
+	for _, u := range updates {
+		if err := u.Tuple.validate(); err != nil {
+			return "", err
+		}
+		union, err := e.namespaces.rewrite(u.Tuple.Namespace, u.Tuple.Relation)
+		if err != nil {
+			return "", err
+		}
+		if !hasThis(union) {
+			return "", fmt.Errorf("%w: %s#%s is computed and can not be written", ErrInvalidTuple, u.Tuple.Namespace, u.Tuple.Relation)
+		}
+	}
+	tx, err := e.client.Tx(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, u := range updates {
+		if isRoleGrant(u.Tuple) {
+			err = writeUserRole(ctx, tx, u)
+		} else {
+			err = writeTuple(ctx, tx, u)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			logrus.WithContext(ctx).Errorf("failed at writing relation tuple %s, err: %v", u.Tuple, err)
+			return "", err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return "", err
+	}
+	// hook 在提交前已清空，提交前并发计算的结果需再清空一次
+	e.invalidate()
+	revision, err := e.revision(ctx)
+	if err != nil {
+		return "", err
+	}
+	return encodeToken(revision), nil
+}
+
+/*
+invalidate 丢弃全部缓存结果，经由 userset、tuple_to_userset 间接受影响的结果无法按元组找出
+其他实例的写入依靠一致性令牌或 cacheTTL 过期
+*/
+func (e *Engine) invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cache = make(map[string]cached)
+}
+
+// invalidateHook 元组、授权或角色变更后丢弃缓存结果，ctx 中有事务时在提交后再丢弃
+func (e *Engine) invalidateHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		value, err := next.Mutate(ctx, m)
+		if err == nil {
+			e.invalidate()
+			rbac.AfterCommit(ctx, e.invalidate)
+		}
+		return value, err
+	})
+}
+
+func hasThis(union []Userset) bool {
+	for _, u := range union {
+		if u.This {
+			return true
+		}
+	}
+	return false
+}
+
+func isRoleGrant(t Tuple) bool {
+	return t.Namespace == RoleNamespace && t.Relation == MemberRelation &&
+		t.Subject.Namespace == UserNamespace && t.Subject.Relation == ""
+}
+
+func writeTuple(ctx context.Context, tx *ent.Tx, u Update) error {
+	t := u.Tuple
+	predicates := relationtuple.And(
+		relationtuple.Namespace(t.Namespace),
+		relationtuple.ObjectID(t.ObjectID),
+		relationtuple.Relation(t.Relation),
+		relationtuple.SubjectNamespace(t.Subject.Namespace),
+		relationtuple.SubjectID(t.Subject.ID),
+		relationtuple.SubjectRelation(t.Subject.Relation),
+	)
+	if u.Operation == Delete {
+		_, err := tx.RelationTuple.Delete().Where(predicates).Exec(ctx)
+		return err
+	}
+	exist, err := tx.RelationTuple.Query().Where(predicates).Exist(ctx)
+	if err != nil || exist {
+		return err
+	}
+	return tx.RelationTuple.Create().
+		SetNamespace(t.Namespace).
+		SetObjectID(t.ObjectID).
+		SetRelation(t.Relation).
+		SetSubjectNamespace(t.Subject.Namespace).
+		SetSubjectID(t.Subject.ID).
+		SetSubjectRelation(t.Subject.Relation).
+		Exec(ctx)
+}
+
+func writeUserRole(ctx context.Context, tx *ent.Tx, u Update) error {
+	roleID := tools.StringToInt64(u.Tuple.ObjectID)
+	userID := tools.StringToInt64(u.Tuple.Subject.ID)
+	if roleID == 0 || userID == 0 {
+		return fmt.Errorf("%w: invalid role grant %s", ErrInvalidTuple, u.Tuple)
+	}
+	if u.Operation == Delete {
+		_, err := tx.UserRole.Delete().Where(userrole.UserID(userID), userrole.RoleID(roleID)).Exec(ctx)
+		return err
+	}
+	// 写入元组即表示当前生效，不限有效期
+	_, err := rbac.Grant(ctx, tx.Client(), userID, roleID, tools.ZeroTime, tools.ZeroTime)
+	return err
+}
@@ -0,0 +1,129 @@
+package rebac
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stark-sim/cas/pkg/audit"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/auditevent"
+	"github.com/stark-sim/cas/pkg/ent/enttest"
+	"github.com/stark-sim/cas/pkg/rbac"
+	"github.com/stark-sim/cas/tools"
+)
+
+var testNamespaces = []Namespace{
+	{Name: "document", Relations: []Relation{
+		{Name: "parent"},
+		{Name: "viewer", Union: []Userset{{This: true}, {TupleToUserset: &TupleToUserset{Tupleset: "parent", ComputedUserset: "viewer"}}}},
+	}},
+	{Name: "folder", Relations: []Relation{{Name: "viewer"}}},
+	{Name: "group", Relations: []Relation{{Name: "member"}}},
+}
+
+// newTestEngine 同一个 dsn 打开的 client 共享数据库，模拟多个实例
+func newTestEngine(t *testing.T, dsn string) (*Engine, *ent.Client) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { _ = client.Close() })
+	audit.Register(client)
+	e, err := NewEngine(client, testNamespaces...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e, client
+}
+
+func testDSN(t *testing.T) string {
+	return "file:" + t.Name() + "?mode=memory&cache=shared&_fk=1"
+}
+
+func tuple(namespace, objectID, relation string, subject Subject) Tuple {
+	return Tuple{Namespace: namespace, ObjectID: objectID, Relation: relation, Subject: subject}
+}
+
+func write(t *testing.T, e *Engine, tuples ...Tuple) string {
+	t.Helper()
+	updates := make([]Update, 0, len(tuples))
+	for _, v := range tuples {
+		updates = append(updates, Update{Operation: Touch, Tuple: v})
+	}
+	token, err := e.Write(context.Background(), updates)
+	if err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	return token
+}
+
+func check(t *testing.T, e *Engine, tuple Tuple, token string) (bool, string) {
+	t.Helper()
+	allowed, revision, err := e.Check(context.Background(), tuple, token)
+	if err != nil {
+		t.Fatalf("check %s: %v", tuple, err)
+	}
+	return allowed, revision
+}
+
+func TestCheckReadsOwnWriteWithSkewedRevision(t *testing.T) {
+	dsn := testDSN(t)
+	a, client := newTestEngine(t, dsn)
+	b, _ := newTestEngine(t, dsn)
+	// 时钟偏快的实例写入的审计日志 ID 比之后的写入都大
+	client.AuditEvent.Create().
+		SetID(tools.SnowflakeIDAt(time.Now().Add(time.Hour))).
+		SetOperation(auditevent.OperationUpdate).
+		SetEntityType(ent.TypeRelationTuple).
+		SetEntityID(1).
+		ExecX(context.Background())
+	alice := Subject{Namespace: UserNamespace, ID: "alice"}
+	viewer := tuple("document", "1", "viewer", alice)
+	if allowed, _ := check(t, a, viewer, ""); allowed {
+		t.Fatal("allowed before write")
+	}
+	// 另一个实例写入，令牌与写入前相同
+	token := write(t, b, viewer)
+	if _, before := check(t, a, viewer, ""); before != token {
+		t.Fatalf("token %s, cached revision %s", token, before)
+	}
+	if allowed, _ := check(t, a, viewer, token); !allowed {
+		t.Error("check with the write token returned the cached result")
+	}
+}
+
+func TestWriteInvalidatesIndirectResults(t *testing.T) {
+	e, _ := newTestEngine(t, testDSN(t))
+	alice := Subject{Namespace: UserNamespace, ID: "alice"}
+	write(t, e,
+		tuple("document", "1", "parent", Subject{Namespace: "folder", ID: "x"}),
+		tuple("group", "eng", "member", alice),
+	)
+	viewer := tuple("document", "1", "viewer", alice)
+	if allowed, _ := check(t, e, viewer, ""); allowed {
+		t.Fatal("allowed before write")
+	}
+	// 写入的元组与缓存的结果既不同 object 也不同 subject
+	write(t, e, tuple("folder", "x", "viewer", Subject{Namespace: "group", ID: "eng", Relation: "member"}))
+	if allowed, _ := check(t, e, viewer, ""); !allowed {
+		t.Error("indirectly affected result was not invalidated")
+	}
+}
+
+func TestGrantInvalidatesResults(t *testing.T) {
+	ctx := context.Background()
+	e, client := newTestEngine(t, testDSN(t))
+	u := client.User.Create().SetPhone("13800000000").SaveX(ctx)
+	r := client.Role.Create().SetName("editor").SaveX(ctx)
+	member := tuple(RoleNamespace, strconv.FormatInt(r.ID, 10), MemberRelation, Subject{Namespace: UserNamespace, ID: strconv.FormatInt(u.ID, 10)})
+	if allowed, _ := check(t, e, member, ""); allowed {
+		t.Fatal("member before grant")
+	}
+	if _, err := rbac.Grant(ctx, client, u.ID, r.ID, tools.ZeroTime, tools.ZeroTime); err != nil {
+		t.Fatal(err)
+	}
+	if allowed, _ := check(t, e, member, ""); !allowed {
+		t.Error("grant did not invalidate the cached result")
+	}
+}
@@ -0,0 +1,106 @@
+package rebac
+
+import (
+	"fmt"
+)
+
+// 内置命名空间，role:<role_id>#member 由 user_roles 提供
+const (
+	UserNamespace  = "user"
+	RoleNamespace  = "role"
+	MemberRelation = "member"
+)
+
+// Namespace 命名空间，每个 relation 的 rewrite 为若干 userset 的并集
+type Namespace struct {
+	Name      string
+	Relations []Relation
+}
+
+type Relation struct {
+	Name string
+	// 为空时等价于只有 this
+	Union []Userset
+}
+
+// Userset 三者取其一
+type Userset struct {
+	// 直接写入该 relation 的元组
+	This bool
+	// 同一对象上另一个 relation 的 userset
+	ComputedUserset string
+	// 先沿 tupleset 找到关联对象，再取其上的 computed_userset
+	TupleToUserset *TupleToUserset
+}
+
+type TupleToUserset struct {
+	Tupleset        string
+	ComputedUserset string
+}
+
+// DefaultNamespaces 内置的 user 与 role 命名空间
+func DefaultNamespaces() []Namespace {
+	return []Namespace{
+		{Name: UserNamespace},
+		{Name: RoleNamespace, Relations: []Relation{{Name: MemberRelation}}},
+	}
+}
+
+type namespaces map[string]map[string][]Userset
+
+func buildNamespaces(configs []Namespace) (namespaces, error) {
+	res := make(namespaces)
+	for _, ns := range configs {
+		if ns.Name == "" {
+			return nil, fmt.Errorf("namespace name is empty")
+		}
+		relations, ok := res[ns.Name]
+		if !ok {
+			relations = make(map[string][]Userset)
+			res[ns.Name] = relations
+		}
+		for _, rel := range ns.Relations {
+			if rel.Name == "" {
+				return nil, fmt.Errorf("relation name is empty in namespace %s", ns.Name)
+			}
+			union := rel.Union
+			if len(union) == 0 {
+				union = []Userset{{This: true}}
+			}
+			relations[rel.Name] = union
+		}
+	}
+	// 校验 rewrite 引用的 relation 都存在
+	for name, relations := range res {
+		for rel, union := range relations {
+			for _, u := range union {
+				if u.ComputedUserset != "" {
+					if _, ok := relations[u.ComputedUserset]; !ok {
+						return nil, fmt.Errorf("%s#%s: unknown computed userset %s", name, rel, u.ComputedUserset)
+					}
+				}
+				if u.TupleToUserset != nil {
+					if _, ok := relations[u.TupleToUserset.Tupleset]; !ok {
+						return nil, fmt.Errorf("%s#%s: unknown tupleset %s", name, rel, u.TupleToUserset.Tupleset)
+					}
+					if u.TupleToUserset.ComputedUserset == "" {
+						return nil, fmt.Errorf("%s#%s: tuple_to_userset needs computed_userset", name, rel)
+					}
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+func (n namespaces) rewrite(namespace, relation string) ([]Userset, error) {
+	relations, ok := n[namespace]
+	if !ok {
+		return nil, fmt.Errorf("%w: namespace %s", ErrUnknownRelation, namespace)
+	}
+	union, ok := relations[relation]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s#%s", ErrUnknownRelation, namespace, relation)
+	}
+	return union, nil
+}
@@ -0,0 +1,69 @@
+package rebac
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Subject 为具体对象（Relation 为空）或 userset namespace:id#relation
+type Subject struct {
+	Namespace string
+	ID        string
+	Relation  string
+}
+
+func (s Subject) String() string {
+	if s.Relation == "" {
+		return fmt.Sprintf("%s:%s", s.Namespace, s.ID)
+	}
+	return fmt.Sprintf("%s:%s#%s", s.Namespace, s.ID, s.Relation)
+}
+
+// Tuple object#relation@subject
+type Tuple struct {
+	Namespace string
+	ObjectID  string
+	Relation  string
+	Subject   Subject
+}
+
+func (t Tuple) String() string {
+	return fmt.Sprintf("%s:%s#%s@%s", t.Namespace, t.ObjectID, t.Relation, t.Subject)
+}
+
+func (t Tuple) validate() error {
+	if t.Namespace == "" || t.ObjectID == "" || t.Relation == "" {
+		return fmt.Errorf("%w %s: object and relation are required", ErrInvalidTuple, t)
+	}
+	if t.Subject.Namespace == "" || t.Subject.ID == "" {
+		return fmt.Errorf("%w %s: subject is required", ErrInvalidTuple, t)
+	}
+	if strings.ContainsAny(t.Namespace+t.ObjectID+t.Relation, ":#@") {
+		return fmt.Errorf("%w %s: reserved character in object", ErrInvalidTuple, t)
+	}
+	return nil
+}
+
+// Operation 元组写入操作
+type Operation int
+
+const (
+	Touch Operation = iota
+	Delete
+)
+
+type Update struct {
+	Operation Operation
+	Tuple     Tuple
+}
+
+// Tree Expand 的结果，叶子节点的 Subjects 为直接关系
+type Tree struct {
+	// union / leaf
+	Operation string
+	Namespace string
+	ObjectID  string
+	Relation  string
+	Subjects  []Subject
+	Children  []*Tree
+}
@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+/*
+Driver 为每条 SQL 语句与事务的提交、回滚创建 span，语句中的参数不记录
+ent 的 BeginTx 要求驱动实现该方法，所以包装具体的 *sql.Driver 而不是 dialect.Driver
+*/
+type Driver struct {
+	*entsql.Driver
+}
+
+// NewDriver 包装 ent 的 SQL 驱动
+func NewDriver(drv *entsql.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, d.Dialect(), query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, d.Dialect(), query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	tx, err := d.Driver.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, ctx: ctx, dialect: d.Dialect()}, nil
+}
+
+// Tx 事务内的语句同样创建 span，提交与回滚使用开启事务时的 ctx
+type Tx struct {
+	dialect.Tx
+	ctx     context.Context
+	dialect string
+}
+
+func (t *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, t.dialect, query, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Query(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, t.dialect, query, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Commit() error {
+	return traceStatement(t.ctx, t.dialect, "COMMIT", func(context.Context) error {
+		return t.Tx.Commit()
+	})
+}
+
+func (t *Tx) Rollback() error {
+	return traceStatement(t.ctx, t.dialect, "ROLLBACK", func(context.Context) error {
+		return t.Tx.Rollback()
+	})
+}
+
+func traceStatement(ctx context.Context, system string, query string, fn func(ctx context.Context) error) error {
+	ctx, span := tracer().Start(ctx, "sql", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.DBSystemKey.String(dbSystem(system)),
+		semconv.DBStatementKey.String(query),
+	))
+	defer span.End()
+	err := fn(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// dbSystem ent 的方言名与语义约定中的 db.system 不完全一致
+func dbSystem(name string) string {
+	switch name {
+	case dialect.Postgres:
+		return semconv.DBSystemPostgreSQL.Value.AsString()
+	case dialect.MySQL:
+		return semconv.DBSystemMySQL.Value.AsString()
+	case dialect.SQLite:
+		return semconv.DBSystemSqlite.Value.AsString()
+	}
+	return name
+}
@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 导出方式
+const (
+	ExporterNone = "none"
+	// 输出到标准输出，便于本地查看
+	ExporterStdout = "stdout"
+	// 以 OTLP JSON 写入文件，每行一批，可由 collector 的 otlpjsonfile receiver 读取
+	ExporterOTLPFile = "otlp-file"
+	// 通过 OTLP/gRPC 发送到 collector
+	ExporterOTLP = "otlp"
+)
+
+const instrumentationName = "github.com/stark-sim/cas"
+
+// Options 为空时不导出，但仍然传递上游的 trace context
+type Options struct {
+	Exporter string
+	// otlp 的地址，如 localhost:4317
+	Endpoint string
+	// otlp 不使用 TLS
+	Insecure bool
+	// otlp-file 的文件路径
+	File string
+	// 采样比例，0 表示全部采样；上游已采样的请求总会采样
+	SampleRatio float64
+	ServiceName string
+	Version     string
+}
+
+/*
+Init 设置全局的 TracerProvider 与 W3C trace context 传播
+返回的函数在退出前调用，导出尚未发送的 span
+*/
+func Init(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	exporter, err := newExporter(ctx, opts)
+	if err != nil || exporter == nil {
+		return func(context.Context) error { return nil }, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(opts.ServiceName),
+		semconv.ServiceVersion(opts.Version),
+	))
+	if err != nil {
+		return nil, err
+	}
+	sampler := sdktrace.AlwaysSample()
+	if opts.SampleRatio > 0 && opts.SampleRatio < 1 {
+		sampler = sdktrace.TraceIDRatioBased(opts.SampleRatio)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, opts Options) (sdktrace.SpanExporter, error) {
+	switch opts.Exporter {
+	case "", ExporterNone:
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLPFile:
+		if opts.File == "" {
+			return nil, fmt.Errorf("tracing exporter %s requires a file", opts.Exporter)
+		}
+		f, err := os.OpenFile(opts.File, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return otlptrace.New(ctx, &fileClient{file: f})
+	case ExporterOTLP:
+		grpcOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+		if opts.Insecure {
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, grpcOpts...)
+	}
+	return nil, fmt.Errorf("unknown tracing exporter %q", opts.Exporter)
+}
+
+// tracer 每次从全局取，Init 之前创建的 span 不会导出
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
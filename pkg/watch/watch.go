@@ -0,0 +1,269 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/auditevent"
+	"github.com/stark-sim/cas/pkg/ent/hook"
+	"github.com/stark-sim/cas/tools"
+)
+
+const (
+	/*
+		DefaultLookback 事件 ID 在写入时生成，提交顺序与 ID 顺序不一致，各实例的时钟也有偏差，
+		每次都重新读取游标之前该时长内的事件，按 ID 去重，晚于游标提交的事件不会被跳过
+	*/
+	DefaultLookback = 30 * time.Second
+	// DefaultPollInterval 没有收到本进程的变更通知时轮询的间隔，其他进程的变更依靠轮询发现
+	DefaultPollInterval = time.Second
+	// 每次从审计日志读取的数量
+	batchSize = 100
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid resume token")
+	ErrUnknownType  = errors.New("unknown entity type")
+)
+
+// Types 可以订阅的实体
+var Types = []string{ent.TypeUser, ent.TypeRole, ent.TypeUserRole}
+
+/*
+Broker 进程内的变更通知，只负责唤醒订阅者，事件本身从审计日志中读取
+订阅者各自按游标拉取，消费慢的订阅者不会阻塞写入或其他订阅者
+*/
+type Broker struct {
+	lookback     time.Duration
+	pollInterval time.Duration
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+	done        chan struct{}
+	closeOnce   sync.Once
+}
+
+// NewBroker lookback 与 pollInterval 不大于 0 时使用默认值
+func NewBroker(lookback, pollInterval time.Duration) *Broker {
+	if lookback <= 0 {
+		lookback = DefaultLookback
+	}
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	return &Broker{
+		lookback:     lookback,
+		pollInterval: pollInterval,
+		subscribers:  make(map[chan struct{}]struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+// Close 服务关闭前调用，所有 Stream 随即返回，客户端凭 token 重连到其他实例
+func (b *Broker) Close() {
+	b.closeOnce.Do(func() {
+		close(b.done)
+	})
+}
+
+// Subscribe 返回通知信道与取消订阅的函数，多次通知会合并为一次
+func (b *Broker) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+	}
+}
+
+// Notify 唤醒所有订阅者，不会阻塞
+func (b *Broker) Notify() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Register 在 User、Role、UserRole 变更成功后通知订阅者
+func Register(client *ent.Client, broker *Broker) {
+	client.Use(hook.If(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			value, err := next.Mutate(ctx, m)
+			if err == nil {
+				broker.Notify()
+			}
+			return value, err
+		})
+	}, hook.Condition(func(_ context.Context, m ent.Mutation) bool {
+		return tools.IsOneOf(m.Type(), Types...)
+	})))
+}
+
+// Token 事件的续传 token，即审计日志的 ID
+func Token(event *ent.AuditEvent) string {
+	return strconv.FormatInt(event.ID, 10)
+}
+
+// ParseToken 空 token 返回 0
+func ParseToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidToken
+	}
+	return id, nil
+}
+
+type Options struct {
+	// 为空时订阅所有 Types
+	Types []string
+	// 上次收到的事件的 token，为空时只推送订阅之后的事件
+	ResumeToken string
+}
+
+/*
+Stream 按审计日志 ID 顺序推送事件，直到 ctx 结束、broker 关闭或 send 返回错误
+审计日志与变更在同一事务中写入，所以服务重启后凭 token 续传不会漏掉事件；
+续传时会重新推送 token 之前 lookback 内的事件，客户端按 token 去重
+*/
+func Stream(ctx context.Context, client *ent.Client, broker *Broker, opts Options, send func(*ent.AuditEvent) error) error {
+	sub, err := Subscribe(ctx, client, broker, opts)
+	if err != nil {
+		return err
+	}
+	return sub.Run(ctx, send)
+}
+
+// Subscription 已确定起点的订阅，须调用 Run 或 Close 释放
+type Subscription struct {
+	broker *Broker
+	window *window
+	notify <-chan struct{}
+	cancel func()
+}
+
+/*
+Subscribe 确定订阅的起点，新订阅只推送 Subscribe 返回之后提交的事件
+在后台推送时应先同步调用 Subscribe 再在后台 Run，否则两者之间提交的事件会被当作已推送
+*/
+func Subscribe(ctx context.Context, client *ent.Client, broker *Broker, opts Options) (*Subscription, error) {
+	for _, t := range opts.Types {
+		if !tools.IsOneOf(t, Types...) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownType, t)
+		}
+	}
+	if len(opts.Types) == 0 {
+		opts.Types = Types
+	}
+	cursor, err := ParseToken(opts.ResumeToken)
+	if err != nil {
+		return nil, err
+	}
+	// 先订阅再确定起点，避免漏掉中间的通知
+	notify, cancel := broker.Subscribe()
+	w := &window{client: client, types: opts.Types, lookback: broker.lookback, cursor: cursor, sent: make(map[int64]bool)}
+	if opts.ResumeToken == "" {
+		// 新订阅只推送之后提交的事件，窗口内已提交的视为已推送
+		w.cursor = tools.SnowflakeIDAt(time.Now())
+		if err = w.scan(ctx, func(event *ent.AuditEvent) error { return nil }); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	return &Subscription{broker: broker, window: w, notify: notify, cancel: cancel}, nil
+}
+
+// Run 推送事件直到 ctx 结束、broker 关闭或 send 返回错误，返回时释放订阅
+func (s *Subscription) Run(ctx context.Context, send func(*ent.AuditEvent) error) error {
+	defer s.cancel()
+	ticker := time.NewTicker(s.broker.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.window.scan(ctx, send); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.broker.done:
+			return nil
+		case <-ticker.C:
+		case <-s.notify:
+		}
+	}
+}
+
+// Close 不再调用 Run 时释放订阅
+func (s *Subscription) Close() {
+	s.cancel()
+}
+
+// window 游标之前 lookback 内已推送的事件
+type window struct {
+	client   *ent.Client
+	types    []string
+	lookback time.Duration
+	// 已推送的最大 ID
+	cursor int64
+	sent   map[int64]bool
+}
+
+// scan 推送窗口内尚未推送的事件，窗口随游标前移
+func (w *window) scan(ctx context.Context, send func(*ent.AuditEvent) error) error {
+	after := w.from()
+	for {
+		events, err := w.client.AuditEvent.Query().
+			Where(auditevent.IDGT(after), auditevent.EntityTypeIn(w.types...)).
+			Order(ent.Asc(auditevent.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			after = event.ID
+			if w.sent[event.ID] {
+				continue
+			}
+			if err = send(event); err != nil {
+				return err
+			}
+			w.sent[event.ID] = true
+			if event.ID > w.cursor {
+				w.cursor = event.ID
+			}
+		}
+		// 丢弃移出窗口的记录，追赶积压时也不会无限增长
+		from := w.from()
+		for id := range w.sent {
+			if id <= from {
+				delete(w.sent, id)
+			}
+		}
+		if len(events) < batchSize {
+			return nil
+		}
+	}
+}
+
+// from 窗口的下界，续传 token 为空时从头读取
+func (w *window) from() int64 {
+	if w.cursor == 0 {
+		return 0
+	}
+	return tools.SnowflakeIDAt(tools.SnowflakeTime(w.cursor).Add(-w.lookback))
+}
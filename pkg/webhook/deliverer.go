@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/webhookdelivery"
+)
+
+const (
+	// DefaultMaxAttempts 达到该次数仍失败的投递转为 DEAD，只能手动重新投递
+	DefaultMaxAttempts = 8
+	// DefaultTimeout 单次请求的超时
+	DefaultTimeout = 10 * time.Second
+	// DefaultDeliverInterval 未配置时检查待投递记录的间隔
+	DefaultDeliverInterval = time.Second
+	// 第 n 次失败后等待 baseBackoff * 2^(n-1)，不超过 maxBackoff
+	baseBackoff = 10 * time.Second
+	maxBackoff  = time.Hour
+	// 每次处理的数量
+	deliverBatchSize = 20
+)
+
+var ErrUnavailable = errors.New("webhook subscription is deleted or disabled")
+
+// Deliverer 投递待发送与待重试的记录
+type Deliverer struct {
+	Client      *ent.Client
+	HTTPClient  *http.Client
+	MaxAttempts int
+}
+
+// NewDeliverer maxAttempts 与 timeout 不大于 0 时使用默认值
+func NewDeliverer(client *ent.Client, maxAttempts int, timeout time.Duration) *Deliverer {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Deliverer{Client: client, HTTPClient: &http.Client{Timeout: timeout}, MaxAttempts: maxAttempts}
+}
+
+// Start 后台定期投递，ctx 结束时退出
+func (d *Deliverer) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultDeliverInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			count, err := d.DeliverDue(ctx)
+			if err != nil && ctx.Err() == nil {
+				logrus.Errorf("failed at delivering webhooks, err: %v", err)
+			}
+			if err == nil && count == deliverBatchSize {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+/*
+DeliverDue 投递一批到期的记录，返回处理的数量
+先把 next_attempt_at 推迟再投递，多个进程同时运行时同一条记录只会被一个进程处理
+*/
+func (d *Deliverer) DeliverDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := d.Client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusIn(webhookdelivery.StatusPending, webhookdelivery.StatusFailed),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(deliverBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, v := range due {
+		// 请求超时之后仍未更新状态的记录会被重新投递
+		affected, err := d.Client.WebhookDelivery.Update().
+			Where(webhookdelivery.ID(v.ID), webhookdelivery.NextAttemptAtEQ(v.NextAttemptAt)).
+			SetNextAttemptAt(now.Add(d.HTTPClient.Timeout + time.Minute)).
+			Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if affected == 0 {
+			continue
+		}
+		if err = d.Deliver(ctx, v); err != nil {
+			return 0, err
+		}
+	}
+	return len(due), nil
+}
+
+// Deliver 投递一次并记录结果，返回的错误只包括记录结果时的错误
+func (d *Deliverer) Deliver(ctx context.Context, delivery *ent.WebhookDelivery) error {
+	update := d.Client.WebhookDelivery.UpdateOne(delivery).AddAttempts(1)
+	subscription, err := delivery.QuerySubscription().Only(ctx)
+	switch {
+	case ent.IsNotFound(err) || (err == nil && !subscription.Enabled):
+		// 订阅已删除或停用，不再重试
+		return update.SetStatus(webhookdelivery.StatusDead).SetLastError(ErrUnavailable.Error()).Exec(ctx)
+	case err != nil:
+		return err
+	}
+	statusCode, err := d.send(ctx, subscription, delivery)
+	update.SetResponseStatus(statusCode)
+	attempts := delivery.Attempts + 1
+	switch {
+	case err == nil:
+		update.SetStatus(webhookdelivery.StatusSucceeded).SetLastError("").SetDeliveredAt(time.Now())
+	case attempts >= d.MaxAttempts:
+		logrus.Warnf("webhook delivery %d dead after %d attempts, err: %v", delivery.ID, attempts, err)
+		update.SetStatus(webhookdelivery.StatusDead).SetLastError(err.Error())
+	default:
+		update.SetStatus(webhookdelivery.StatusFailed).SetLastError(err.Error()).SetNextAttemptAt(time.Now().Add(backoff(attempts)))
+	}
+	return update.Exec(ctx)
+}
+
+// send 2xx 视为成功，返回响应码，请求未完成时为 0
+func (d *Deliverer) send(ctx context.Context, subscription *ent.WebhookSubscription, delivery *ent.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, strings.NewReader(delivery.Body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, []byte(delivery.Body)))
+	resp, err := d.HTTPClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// 读完响应以复用连接，不关心内容
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func backoff(attempts int) time.Duration {
+	wait := baseBackoff
+	for i := 1; i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+	return wait
+}
+
+// Replay 重新投递，重置重试次数，已成功的记录也会再次发送
+func Replay(ctx context.Context, client *ent.Client, id int64) (*ent.WebhookDelivery, error) {
+	return client.WebhookDelivery.UpdateOneID(id).
+		SetStatus(webhookdelivery.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		SetLastError("").
+		Save(ctx)
+}
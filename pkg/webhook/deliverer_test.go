@@ -0,0 +1,282 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/enttest"
+	"github.com/stark-sim/cas/pkg/ent/webhookdelivery"
+	"github.com/stark-sim/cas/tools"
+)
+
+const testSecret = "s3cret"
+
+// receiver 记录收到的回调，按 status 响应
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	status   int
+	requests []received
+}
+
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T, status int) *receiver {
+	r := &receiver{status: status}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.requests = append(r.requests, received{header: req.Header.Clone(), body: body})
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *receiver) received() []received {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]received(nil), r.requests...)
+}
+
+func openClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func newSubscription(t *testing.T, client *ent.Client, url string) *ent.WebhookSubscription {
+	t.Helper()
+	return client.WebhookSubscription.Create().SetURL(url).SetSecret(testSecret).SaveX(context.Background())
+}
+
+func newDelivery(t *testing.T, client *ent.Client, subscription *ent.WebhookSubscription) *ent.WebhookDelivery {
+	t.Helper()
+	return client.WebhookDelivery.Create().
+		SetSubscription(subscription).
+		SetEventID(tools.GenSnowflakeID()).
+		SetEventType("user.registered").
+		SetBody(`{"type":"user.registered"}`).
+		SaveX(context.Background())
+}
+
+// deliver 投递一次并返回更新后的记录
+func deliver(t *testing.T, d *Deliverer, delivery *ent.WebhookDelivery) *ent.WebhookDelivery {
+	t.Helper()
+	ctx := context.Background()
+	if err := d.Deliver(ctx, delivery); err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	return d.Client.WebhookDelivery.GetX(ctx, delivery.ID)
+}
+
+func TestDeliverSucceeded(t *testing.T) {
+	client := openClient(t)
+	r := newReceiver(t, http.StatusNoContent)
+	delivery := newDelivery(t, client, newSubscription(t, client, r.URL))
+	before := time.Now()
+	got := deliver(t, NewDeliverer(client, 0, 0), delivery)
+	if got.Status != webhookdelivery.StatusSucceeded || got.Attempts != 1 || got.ResponseStatus != http.StatusNoContent || got.LastError != "" {
+		t.Errorf("status %s, attempts %d, response %d, last_error %q", got.Status, got.Attempts, got.ResponseStatus, got.LastError)
+	}
+	if got.DeliveredAt.Before(before) {
+		t.Errorf("delivered_at %v", got.DeliveredAt)
+	}
+	requests := r.received()
+	if len(requests) != 1 {
+		t.Fatalf("received %d requests", len(requests))
+	}
+	header, body := requests[0].header, requests[0].body
+	if string(body) != delivery.Body {
+		t.Errorf("body %s", body)
+	}
+	if header.Get("Content-Type") != "application/json" || header.Get(HeaderEvent) != delivery.EventType || header.Get(HeaderDelivery) != strconv.FormatInt(delivery.ID, 10) {
+		t.Errorf("headers %v", header)
+	}
+	timestamp, err := strconv.ParseInt(header.Get(HeaderTimestamp), 10, 64)
+	if err != nil || timestamp < before.Unix() || timestamp > time.Now().Unix() {
+		t.Errorf("timestamp %q", header.Get(HeaderTimestamp))
+	}
+	if want := Sign(testSecret, timestamp, body); header.Get(HeaderSignature) != want {
+		t.Errorf("signature %q, want %q", header.Get(HeaderSignature), want)
+	}
+	// 密钥或时间戳不同时签名不同
+	if Sign("other", timestamp, body) == header.Get(HeaderSignature) || Sign(testSecret, timestamp+1, body) == header.Get(HeaderSignature) {
+		t.Error("signature does not cover secret and timestamp")
+	}
+}
+
+func TestDeliverFailed(t *testing.T) {
+	client := openClient(t)
+	r := newReceiver(t, http.StatusInternalServerError)
+	delivery := newDelivery(t, client, newSubscription(t, client, r.URL))
+	d := NewDeliverer(client, 0, 0)
+	for attempts, wait := range []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second} {
+		before := time.Now()
+		delivery = deliver(t, d, delivery)
+		if delivery.Status != webhookdelivery.StatusFailed || delivery.Attempts != attempts+1 || delivery.ResponseStatus != http.StatusInternalServerError || delivery.LastError == "" {
+			t.Fatalf("status %s, attempts %d, response %d, last_error %q", delivery.Status, delivery.Attempts, delivery.ResponseStatus, delivery.LastError)
+		}
+		if delivery.NextAttemptAt.Before(before.Add(wait)) || delivery.NextAttemptAt.After(time.Now().Add(wait)) {
+			t.Errorf("attempt %d: next_attempt_at in %v, want %v", attempts+1, delivery.NextAttemptAt.Sub(before), wait)
+		}
+	}
+	// 恢复后成功
+	r.setStatus(http.StatusOK)
+	if delivery = deliver(t, d, delivery); delivery.Status != webhookdelivery.StatusSucceeded || delivery.Attempts != 4 {
+		t.Errorf("status %s, attempts %d", delivery.Status, delivery.Attempts)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{9, 2560 * time.Second},
+		{10, time.Hour},
+		{100, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestDeliverDead(t *testing.T) {
+	client := openClient(t)
+	r := newReceiver(t, http.StatusBadGateway)
+	delivery := newDelivery(t, client, newSubscription(t, client, r.URL))
+	d := NewDeliverer(client, 2, 0)
+	if delivery = deliver(t, d, delivery); delivery.Status != webhookdelivery.StatusFailed {
+		t.Fatalf("status %s after 1 attempt", delivery.Status)
+	}
+	if delivery = deliver(t, d, delivery); delivery.Status != webhookdelivery.StatusDead || delivery.Attempts != 2 || delivery.LastError == "" {
+		t.Errorf("status %s, attempts %d, last_error %q", delivery.Status, delivery.Attempts, delivery.LastError)
+	}
+	// 请求未完成时没有响应码
+	r.Close()
+	delivery = deliver(t, NewDeliverer(client, 0, 0), newDelivery(t, client, newSubscription(t, client, r.URL)))
+	if delivery.Status != webhookdelivery.StatusFailed || delivery.ResponseStatus != 0 || delivery.LastError == "" {
+		t.Errorf("unreachable: status %s, response %d, last_error %q", delivery.Status, delivery.ResponseStatus, delivery.LastError)
+	}
+}
+
+func TestDeliverUnavailable(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		disable func(client *ent.Client, subscription *ent.WebhookSubscription)
+	}{
+		{name: "disabled", disable: func(client *ent.Client, subscription *ent.WebhookSubscription) {
+			client.WebhookSubscription.UpdateOne(subscription).SetEnabled(false).ExecX(ctx)
+		}},
+		{name: "deleted", disable: func(client *ent.Client, subscription *ent.WebhookSubscription) {
+			client.WebhookSubscription.DeleteOne(subscription).ExecX(ctx)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := openClient(t)
+			r := newReceiver(t, http.StatusOK)
+			subscription := newSubscription(t, client, r.URL)
+			delivery := newDelivery(t, client, subscription)
+			tt.disable(client, subscription)
+			delivery = deliver(t, NewDeliverer(client, 0, 0), delivery)
+			if delivery.Status != webhookdelivery.StatusDead || delivery.Attempts != 1 || delivery.LastError != ErrUnavailable.Error() {
+				t.Errorf("status %s, attempts %d, last_error %q", delivery.Status, delivery.Attempts, delivery.LastError)
+			}
+			if n := len(r.received()); n != 0 {
+				t.Errorf("received %d requests", n)
+			}
+		})
+	}
+}
+
+func TestDeliverDue(t *testing.T) {
+	ctx := context.Background()
+	client := openClient(t)
+	r := newReceiver(t, http.StatusOK)
+	subscription := newSubscription(t, client, r.URL)
+	pending := newDelivery(t, client, subscription)
+	retry := newDelivery(t, client, subscription)
+	client.WebhookDelivery.UpdateOne(retry).SetStatus(webhookdelivery.StatusFailed).SetAttempts(1).ExecX(ctx)
+	later := newDelivery(t, client, subscription)
+	client.WebhookDelivery.UpdateOne(later).SetStatus(webhookdelivery.StatusFailed).SetNextAttemptAt(time.Now().Add(time.Hour)).ExecX(ctx)
+	dead := newDelivery(t, client, subscription)
+	client.WebhookDelivery.UpdateOne(dead).SetStatus(webhookdelivery.StatusDead).ExecX(ctx)
+	d := NewDeliverer(client, 0, 0)
+	count, err := d.DeliverDue(ctx)
+	if err != nil || count != 2 {
+		t.Fatalf("delivered %d, err: %v", count, err)
+	}
+	want := map[int64]webhookdelivery.Status{
+		pending.ID: webhookdelivery.StatusSucceeded,
+		retry.ID:   webhookdelivery.StatusSucceeded,
+		later.ID:   webhookdelivery.StatusFailed,
+		dead.ID:    webhookdelivery.StatusDead,
+	}
+	for id, status := range want {
+		if got := client.WebhookDelivery.GetX(ctx, id); got.Status != status {
+			t.Errorf("delivery %d: status %s, want %s", id, got.Status, status)
+		}
+	}
+	if count, err = d.DeliverDue(ctx); err != nil || count != 0 {
+		t.Errorf("delivered %d again, err: %v", count, err)
+	}
+	if n := len(r.received()); n != 2 {
+		t.Errorf("received %d requests", n)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	ctx := context.Background()
+	client := openClient(t)
+	r := newReceiver(t, http.StatusInternalServerError)
+	delivery := newDelivery(t, client, newSubscription(t, client, r.URL))
+	d := NewDeliverer(client, 1, 0)
+	if delivery = deliver(t, d, delivery); delivery.Status != webhookdelivery.StatusDead {
+		t.Fatalf("status %s", delivery.Status)
+	}
+	replayed, err := Replay(ctx, client, delivery.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.Status != webhookdelivery.StatusPending || replayed.Attempts != 0 || replayed.LastError != "" || replayed.NextAttemptAt.After(time.Now()) {
+		t.Errorf("status %s, attempts %d, last_error %q, next_attempt_at %v", replayed.Status, replayed.Attempts, replayed.LastError, replayed.NextAttemptAt)
+	}
+	// 重置后重新计算重试次数
+	r.setStatus(http.StatusOK)
+	if count, err := d.DeliverDue(ctx); err != nil || count != 1 {
+		t.Fatalf("delivered %d, err: %v", count, err)
+	}
+	if got := client.WebhookDelivery.GetX(ctx, delivery.ID); got.Status != webhookdelivery.StatusSucceeded || got.Attempts != 1 {
+		t.Errorf("status %s, attempts %d", got.Status, got.Attempts)
+	}
+	if _, err = Replay(ctx, client, tools.GenSnowflakeID()); !ent.IsNotFound(err) {
+		t.Errorf("replay unknown delivery: %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/stark-sim/cas/pkg/ent"
+	"github.com/stark-sim/cas/pkg/ent/webhooksubscription"
+	"github.com/stark-sim/cas/pkg/outbox"
+)
+
+// 回调请求携带的请求头
+const (
+	HeaderEvent     = "X-CAS-Event"
+	HeaderDelivery  = "X-CAS-Delivery"
+	HeaderTimestamp = "X-CAS-Timestamp"
+	HeaderSignature = "X-CAS-Signature"
+)
+
+/*
+Sign 计算请求签名：HMAC-SHA256(secret, "<timestamp>.<body>")，格式为 sha256=<hex>
+接收方按同样方式计算并比较，同时校验时间戳防止重放
+*/
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Matches 过滤为空时匹配全部事件，user.* 匹配 user. 开头的事件
+func Matches(filter []string, eventType string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, v := range filter {
+		if v == eventType || v == "*" {
+			return true
+		}
+		if strings.HasSuffix(v, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(v, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Publisher 实现 outbox.Publisher，为匹配的订阅各生成一条投递记录，由 Deliverer 投递
+同一事件重复发布时，唯一索引保证不会重复生成
+*/
+type Publisher struct {
+	Client *ent.Client
+}
+
+func (p *Publisher) Publish(ctx context.Context, message outbox.Message) error {
+	subscriptions, err := p.Client.WebhookSubscription.Query().Where(webhooksubscription.Enabled(true)).All(ctx)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	for _, v := range subscriptions {
+		if !Matches(v.EventTypes, message.Type) {
+			continue
+		}
+		err = p.Client.WebhookDelivery.Create().
+			SetSubscriptionID(v.ID).
+			SetEventID(message.ID).
+			SetEventType(message.Type).
+			SetBody(string(body)).
+			Exec(ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,15 +1,16 @@
 package tools
 
 import (
+	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v4"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
 const (
 	TokenIssuer = "StarkSim"
-	SecretKey   = "42wqTE23123wffLU94342wgadgFs"
 
 	AccessTokenExp  = time.Hour * 2
 	RefreshTokenExp = time.Hour * 12
@@ -18,6 +19,35 @@ const (
 	CookieName = "Authorization"
 )
 
+// keyring 签发使用 current，校验依次尝试 current 与 previous，整体替换
+type keyring struct {
+	current  []byte
+	previous [][]byte
+}
+
+var keys atomic.Pointer[keyring]
+
+/*
+SetSecretKey 设置签发 JWT 的密钥，previous 为轮换前的密钥，只用于校验
+可在运行中调用，之后签发与校验的 token 立即使用新的密钥
+*/
+func SetSecretKey(key string, previous ...string) {
+	ring := &keyring{current: []byte(key)}
+	for _, v := range previous {
+		if v != "" {
+			ring.previous = append(ring.previous, []byte(v))
+		}
+	}
+	keys.Store(ring)
+}
+
+func currentKeys() *keyring {
+	if ring := keys.Load(); ring != nil {
+		return ring
+	}
+	return &keyring{}
+}
+
 type CustomClaims struct {
 	UserID int64
 	jwt.RegisteredClaims
@@ -34,20 +64,32 @@ func GetToken(CreateAt time.Time, UserID int64) (string, error) {
 		},
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, customClaims)
-	signedToken, err := token.SignedString([]byte(SecretKey))
+	signedToken, err := token.SignedString(currentKeys().current)
 	//refreshToken := base64.URLEncoding.EncodeToString(utils.NewSHA1(utils.Must(utils.NewRandom()), []byte(access)).Bytes())
 	//refreshToken = strings.ToUpper(strings.TrimRight(refreshToken, "="))
 	return JWTHeader + signedToken, err
 }
 
-// ParseToken 解析token
+// ParseToken 解析token，依次尝试当前与轮换前的密钥
 func ParseToken(tokenString string) (*CustomClaims, error) {
 	tokenString = strings.TrimPrefix(tokenString, JWTHeader)
+	ring := currentKeys()
+	claims, err := parseToken(tokenString, ring.current)
+	for _, key := range ring.previous {
+		if !errors.Is(err, jwt.ErrSignatureInvalid) {
+			break
+		}
+		claims, err = parseToken(tokenString, key)
+	}
+	return claims, err
+}
+
+func parseToken(tokenString string, key []byte) (*CustomClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(SecretKey), nil
+		return key, nil
 	})
 	if err != nil {
 		return nil, err
@@ -58,3 +100,16 @@ func ParseToken(tokenString string) (*CustomClaims, error) {
 		return nil, err
 	}
 }
+
+// CheckSigningKey 用当前密钥签发并解析一次 token，确认密钥可用
+func CheckSigningKey() error {
+	if len(currentKeys().current) == 0 {
+		return errors.New("signing key is empty")
+	}
+	token, err := GetToken(time.Now(), SystemUserID)
+	if err != nil {
+		return err
+	}
+	_, err = ParseToken(token)
+	return err
+}
@@ -0,0 +1,13 @@
+package tools
+
+import (
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ForUpdate 作为查询条件给选中的行加锁，SQLite 不支持 FOR UPDATE，其写事务本身是串行的
+func ForUpdate(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate()
+	}
+}
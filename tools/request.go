@@ -0,0 +1,53 @@
+package tools
+
+import (
+	"context"
+	"strconv"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	// ReadPrimaryHeader 值为 true 时只读请求也在主库执行，用于跨实例的读己之写
+	ReadPrimaryHeader = "X-Read-Primary"
+
+	// SystemUserID 迁移、种子数据、后台任务等系统操作使用的操作人
+	SystemUserID int64 = 1
+
+	// ctx 中保存请求上下文的 key，与鉴权中间件保持一致
+	UserIDKey    = "UserID"
+	ClientIPKey  = "ClientIP"
+	RequestIDKey = "RequestID"
+)
+
+// NewRequestID 请求方未携带时生成请求 ID
+func NewRequestID() string {
+	return strconv.FormatInt(GenSnowflakeID(), 10)
+}
+
+// WithRequestMeta 将客户端 IP 与请求 ID 保存到 ctx 中
+func WithRequestMeta(ctx context.Context, clientIP string, requestID string) context.Context {
+	ctx = context.WithValue(ctx, ClientIPKey, clientIP)
+	ctx = context.WithValue(ctx, RequestIDKey, requestID)
+	return ctx
+}
+
+// GetUserID 当前操作人，未登录时为 0
+func GetUserID(ctx context.Context) int64 {
+	userID, _ := ctx.Value(UserIDKey).(int64)
+	return userID
+}
+
+// WithSystemPrincipal 以系统身份执行后续操作
+func WithSystemPrincipal(ctx context.Context) context.Context {
+	return context.WithValue(ctx, UserIDKey, SystemUserID)
+}
+
+func GetClientIP(ctx context.Context) string {
+	clientIP, _ := ctx.Value(ClientIPKey).(string)
+	return clientIP
+}
+
+func GetRequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(RequestIDKey).(string)
+	return requestID
+}
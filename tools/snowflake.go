@@ -4,6 +4,7 @@ import (
 	"github.com/bwmarrin/snowflake"
 	"github.com/sirupsen/logrus"
 	"math/rand"
+	"time"
 )
 
 var (
@@ -29,3 +30,17 @@ func GenSnowflakeID() int64 {
 	id := node.Generate()
 	return id.Int64()
 }
+
+// SnowflakeIDAt t 时刻能生成的最小 ID，用于按时间划定 ID 的范围
+func SnowflakeIDAt(t time.Time) int64 {
+	ms := t.UnixMilli() - snowflake.Epoch
+	if ms < 0 {
+		return 0
+	}
+	return ms << (snowflake.NodeBits + snowflake.StepBits)
+}
+
+// SnowflakeTime 生成 ID 的时刻，以生成 ID 的进程的时钟为准
+func SnowflakeTime(id int64) time.Time {
+	return time.UnixMilli(snowflake.ParseInt64(id).Time())
+}